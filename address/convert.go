@@ -0,0 +1,115 @@
+package address
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	// NetworkMainnet is the name of the mainnet prefixes
+	NetworkMainnet = "mainnet"
+	// NetworkLegacyTestnet is the name of the prefixes of the legacy testnets
+	NetworkLegacyTestnet = "testnet"
+)
+
+var (
+	// MainnetPrefixes defines the Bech32 prefixes of the mainnet
+	MainnetPrefixes = Bech32Prefixes{
+		AccAddr:  Bech32PrefixAccAddr,
+		AccPub:   Bech32PrefixAccPub,
+		ValAddr:  Bech32PrefixValAddr,
+		ValPub:   Bech32PrefixValPub,
+		ConsAddr: Bech32PrefixConsAddr,
+		ConsPub:  Bech32PrefixConsPub,
+	}
+
+	// LegacyTestnetPrefixes defines the Bech32 prefixes of the legacy testnets
+	LegacyTestnetPrefixes = Bech32Prefixes{
+		AccAddr:  "faa",
+		AccPub:   "fap",
+		ValAddr:  "fva",
+		ValPub:   "fvp",
+		ConsAddr: "fca",
+		ConsPub:  "fcp",
+	}
+
+	// the lowercase Bech32 strings, the separator is the last 1 as it is not in the data charset
+	regexpBech32 = regexp.MustCompile(`\b[a-z0-9]+1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{6,}\b`)
+)
+
+// Bech32Prefixes defines the Bech32 prefixes of the address and public key forms of a network
+type Bech32Prefixes struct {
+	AccAddr  string
+	AccPub   string
+	ValAddr  string
+	ValPub   string
+	ConsAddr string
+	ConsPub  string
+}
+
+// GetBech32Prefixes returns the prefixes of the named network. Any other name is taken as
+// the account address prefix and the other prefixes follow the Cosmos SDK convention.
+func GetBech32Prefixes(name string) (Bech32Prefixes, error) {
+	switch name {
+	case NetworkMainnet:
+		return MainnetPrefixes, nil
+	case NetworkLegacyTestnet:
+		return LegacyTestnetPrefixes, nil
+	}
+
+	if name == "" || strings.ToLower(name) != name || strings.Contains(name, "1") {
+		return Bech32Prefixes{}, fmt.Errorf("invalid bech32 prefix: %q", name)
+	}
+
+	return Bech32Prefixes{
+		AccAddr:  name,
+		AccPub:   name + "pub",
+		ValAddr:  name + "valoper",
+		ValPub:   name + "valoperpub",
+		ConsAddr: name + "valcons",
+		ConsPub:  name + "valconspub",
+	}, nil
+}
+
+// forms returns the prefixes in a fixed order of the forms
+func (p Bech32Prefixes) forms() []string {
+	return []string{p.AccAddr, p.AccPub, p.ValAddr, p.ValPub, p.ConsAddr, p.ConsPub}
+}
+
+// ConvertBech32 converts the Bech32 string of any address or public key form with one of the source
+// prefixes to the same form with the target prefixes
+func ConvertBech32(bech string, target Bech32Prefixes, sources ...Bech32Prefixes) (string, error) {
+	hrp, bz, err := bech32.DecodeAndConvert(bech)
+	if err != nil {
+		return "", err
+	}
+
+	for _, source := range sources {
+		for i, prefix := range source.forms() {
+			if prefix == hrp {
+				return bech32.ConvertAndEncode(target.forms()[i], bz)
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unknown bech32 prefix: %s", hrp)
+}
+
+// ConvertBech32Text converts all the Bech32 strings with one of the source prefixes in the text,
+// and returns the converted text along with the number of the converted strings.
+// The other content of the text is left untouched, so that CSV and JSON files keep their layouts.
+func ConvertBech32Text(text []byte, target Bech32Prefixes, sources ...Bech32Prefixes) ([]byte, int) {
+	count := 0
+	converted := regexpBech32.ReplaceAllFunc(text, func(bech []byte) []byte {
+		result, err := ConvertBech32(string(bech), target, sources...)
+		if err != nil {
+			return bech
+		}
+		count++
+		return []byte(result)
+	})
+	return converted, count
+}
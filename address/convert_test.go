@@ -0,0 +1,57 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertBech32(t *testing.T) {
+	cosmos, err := GetBech32Prefixes("cosmos")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		bech    string
+		target  Bech32Prefixes
+		sources []Bech32Prefixes
+		want    string
+		wantErr bool
+	}{
+		{"testnet to mainnet", "faa1rulhmls7g9cjh239vnkjnw870t5urrutgxg5m9", MainnetPrefixes, []Bech32Prefixes{LegacyTestnetPrefixes}, "iaa1rulhmls7g9cjh239vnkjnw870t5urrutsfwvmc", false},
+		{"mainnet to testnet", "iaa1rulhmls7g9cjh239vnkjnw870t5urrutsfwvmc", LegacyTestnetPrefixes, []Bech32Prefixes{MainnetPrefixes}, "faa1rulhmls7g9cjh239vnkjnw870t5urrutgxg5m9", false},
+		{"validator to cosmos", "iva1rulhmls7g9cjh239vnkjnw870t5urrut9cyrxl", cosmos, []Bech32Prefixes{MainnetPrefixes}, "cosmosvaloper1rulhmls7g9cjh239vnkjnw870t5urrutql6g46", false},
+		{"unknown source", "iaa1rulhmls7g9cjh239vnkjnw870t5urrutsfwvmc", MainnetPrefixes, []Bech32Prefixes{LegacyTestnetPrefixes}, "", true},
+		{"invalid checksum", "iaa1rulhmls7g9cjh239vnkjnw870t5urrutsfwvma", LegacyTestnetPrefixes, []Bech32Prefixes{MainnetPrefixes}, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ConvertBech32(tt.bech, tt.target, tt.sources...)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.want, got, tt.name)
+		}
+	}
+}
+
+func TestConvertBech32Text(t *testing.T) {
+	text := `{"address":"faa1rulhmls7g9cjh239vnkjnw870t5urrutgxg5m9","other":"cosmos1rulhmls7g9cjh239vnkjnw870t5urrutl63mzv","id":"abc1def"}`
+	want := `{"address":"iaa1rulhmls7g9cjh239vnkjnw870t5urrutsfwvmc","other":"cosmos1rulhmls7g9cjh239vnkjnw870t5urrutl63mzv","id":"abc1def"}`
+
+	converted, count := ConvertBech32Text([]byte(text), MainnetPrefixes, LegacyTestnetPrefixes)
+	require.Equal(t, want, string(converted))
+	require.Equal(t, 1, count)
+}
+
+func TestGetBech32Prefixes(t *testing.T) {
+	_, err := GetBech32Prefixes("Cosmos")
+	require.Error(t, err)
+	_, err = GetBech32Prefixes("")
+	require.Error(t, err)
+
+	prefixes, err := GetBech32Prefixes(NetworkMainnet)
+	require.NoError(t, err)
+	require.Equal(t, MainnetPrefixes, prefixes)
+}
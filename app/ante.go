@@ -7,42 +7,60 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
 	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
+	servicekeeper "github.com/irisnet/irismod/modules/service/keeper"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 
+	feegrantkeeper "github.com/irisnet/irishub/modules/feegrant/keeper"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	memokeeper "github.com/irisnet/irishub/modules/memo/keeper"
+	ratelimitkeeper "github.com/irisnet/irishub/modules/ratelimit/keeper"
+	irisservicekeeper "github.com/irisnet/irishub/modules/service/keeper"
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer or the fee granter.
 func NewAnteHandler(
 	ak authkeeper.AccountKeeper,
 	bk bankkeeper.Keeper,
 	tk tokenkeeper.Keeper,
+	ck coinswapkeeper.Keeper,
 	ok oraclekeeper.Keeper,
+	sk servicekeeper.Keeper,
+	ssk irisservicekeeper.Keeper,
 	gk guardiankeeper.Keeper,
+	fk feegrantkeeper.Keeper,
+	mk memokeeper.Keeper,
+	rk ratelimitkeeper.Keeper,
+	limiter *TxRateLimiter,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+		NewMempoolFeeDecorator(ck, gk),
 		ante.NewValidateBasicDecorator(),
 		ante.TxTimeoutHeightDecorator{},
 		ante.NewValidateMemoDecorator(ak),
+		NewValidateMsgsDecorator(NewRejectMetricsDecorator("memo_required", NewValidateMemoRequiredDecorator(mk))),
 		ante.NewConsumeGasForTxSizeDecorator(ak),
-		ante.NewRejectFeeGranterDecorator(),
 		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(ak),
-		ante.NewDeductFeeDecorator(ak, bk),
+		NewDeductFeeDecorator(ak, bk, ck, gk, fk),
 		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		ante.NewSigVerificationDecorator(ak, signModeHandler),
-		NewValidateTokenDecorator(tk),
-		tokenkeeper.NewValidateTokenFeeDecorator(tk, bk),
-		oraclekeeper.NewValidateOracleAuthDecorator(ok, gk),
-		NewValidateServiceDecorator(),
+		NewValidateMsgsDecorator(
+			NewRejectMetricsDecorator("token", NewValidateTokenDecorator(tk)),
+			NewRejectMetricsDecorator("transfer_quota", NewValidateTransferQuotaDecorator(rk)),
+			NewRejectMetricsDecorator("token_fee", tokenkeeper.NewValidateTokenFeeDecorator(tk, bk)),
+			NewRejectMetricsDecorator("oracle_auth", oraclekeeper.NewValidateOracleAuthDecorator(ok, gk)),
+			NewRejectMetricsDecorator("service", NewValidateServiceDecorator(sk, ssk, gk)),
+			NewRejectMetricsDecorator("schedule_fee", NewValidateScheduleFeeDecorator(gk)),
+		),
+		NewRateLimitDecorator(limiter, gk), // RateLimitDecorator must be called last before the sequence is incremented so that only the accepted txs are counted
 		ante.NewIncrementSequenceDecorator(ak),
 	)
 }
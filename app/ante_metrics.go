@@ -0,0 +1,78 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+// RejectMetricsDecorator counts the txs rejected by the wrapped decorator by the reason and the msg type.
+// The rejections of the decorators after the wrapped one are not counted.
+type RejectMetricsDecorator struct {
+	name      string
+	decorator sdk.AnteDecorator
+}
+
+// NewRejectMetricsDecorator returns an instance of RejectMetricsDecorator wrapping the named decorator
+func NewRejectMetricsDecorator(name string, decorator sdk.AnteDecorator) RejectMetricsDecorator {
+	return RejectMetricsDecorator{
+		name:      name,
+		decorator: decorator,
+	}
+}
+
+// AnteHandle checks the transaction
+func (rmd RejectMetricsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	passed := false
+	newCtx, err := rmd.decorator.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		passed = true
+		return next(ctx, tx, simulate)
+	})
+
+	// the rechecked and simulated txs are not counted, so that each rejection is counted once
+	if err != nil && !passed && !simulate && !ctx.IsReCheckTx() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "ante", "rejected"}, 1,
+			[]metrics.Label{
+				telemetry.NewLabel("decorator", rmd.name),
+				telemetry.NewLabel("reason", rejectReason(err)),
+				telemetry.NewLabel("msg_type", rmd.rejectedMsgType(ctx, tx)),
+			},
+		)
+	}
+
+	return newCtx, err
+}
+
+// rejectedMsgType returns the type of the first msg rejected by the decorator on its own.
+// The msgs are checked on a cached context without gas limit, so that the state is left untouched.
+func (rmd RejectMetricsDecorator) rejectedMsgType(ctx sdk.Context, tx sdk.Tx) string {
+	var memo string
+	if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+		memo = memoTx.GetMemo()
+	}
+
+	terminator := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	for _, msg := range tx.GetMsgs() {
+		cacheCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		if _, err := rmd.decorator.AnteHandle(cacheCtx, msgsTx{msgs: []sdk.Msg{msg}, memo: memo}, false, terminator); err != nil {
+			return msgrouter.TypeURL(msg)
+		}
+	}
+	return "unknown"
+}
+
+// rejectReason returns the description of the registered error causing the rejection
+func rejectReason(err error) string {
+	var sdkErr *sdkerrors.Error
+	if errors.As(err, &sdkErr) {
+		return sdkErr.Error()
+	}
+	return "unknown"
+}
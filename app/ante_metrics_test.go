@@ -0,0 +1,74 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+)
+
+func TestRejectMetricsDecorator(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "iris", PrometheusRetentionTime: 60})
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	sender := sdk.AccAddress([]byte("sender______________"))
+
+	send := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	transfer := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID, "channel-0", sdk.NewInt64Coin("swap/uiris", 1), sender, "receiver", clienttypes.NewHeight(0, 100), 0,
+	)
+
+	decorator := NewRejectMetricsDecorator("token", NewValidateTokenDecorator(app.tokenKeeper))
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	failingNext := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, sdkerrors.ErrUnauthorized
+	}
+
+	// the rejection is counted with the type of the rejected msg
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{send, transfer}}, false, next)
+	require.Error(t, err)
+
+	// neither the simulated txs nor the rejections of the following decorators are counted
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{transfer}}, true, next)
+	require.Error(t, err)
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{send}}, false, failingNext)
+	require.Error(t, err)
+
+	// the service msgs are counted with the type of their requests
+	svcTransfer := sdk.ServiceMsg{MethodName: "/ibc.applications.transfer.v1.Msg/Transfer", Request: transfer}
+	msgsDecorator := NewRejectMetricsDecorator("token_msgs", NewValidateMsgsDecorator(NewValidateTokenDecorator(app.tokenKeeper)))
+	_, err = msgsDecorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{send, svcTransfer}}, false, next)
+	require.Error(t, err)
+
+	gr, err := m.Gather(telemetry.FormatPrometheus)
+	require.NoError(t, err)
+	require.Contains(t, string(gr.Metrics),
+		`iris_tx_ante_rejected{decorator="token",msg_type="/ibc.applications.transfer.v1.MsgTransfer",reason="invalid request"} 1`,
+	)
+	require.Contains(t, string(gr.Metrics),
+		`iris_tx_ante_rejected{decorator="token_msgs",msg_type="/ibc.applications.transfer.v1.MsgTransfer",reason="invalid request"} 1`,
+	)
+	require.NotContains(t, string(gr.Metrics), `reason="unauthorized"`)
+}
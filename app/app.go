@@ -6,7 +6,6 @@ import (
 	"path/filepath"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -32,7 +31,6 @@ import (
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -106,12 +104,36 @@ import (
 	"github.com/irisnet/irishub/address"
 	irisappparams "github.com/irisnet/irishub/app/params"
 	"github.com/irisnet/irishub/lite"
+	"github.com/irisnet/irishub/modules/authz"
+	authzkeeper "github.com/irisnet/irishub/modules/authz/keeper"
+	authztypes "github.com/irisnet/irishub/modules/authz/types"
+	"github.com/irisnet/irishub/modules/feegrant"
+	feegrantkeeper "github.com/irisnet/irishub/modules/feegrant/keeper"
+	feegranttypes "github.com/irisnet/irishub/modules/feegrant/types"
 	"github.com/irisnet/irishub/modules/guardian"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/modules/memo"
+	memokeeper "github.com/irisnet/irishub/modules/memo/keeper"
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
 	"github.com/irisnet/irishub/modules/mint"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/nfttransfer"
+	nfttransferkeeper "github.com/irisnet/irishub/modules/nfttransfer/keeper"
+	nfttransfertypes "github.com/irisnet/irishub/modules/nfttransfer/types"
+	"github.com/irisnet/irishub/modules/ratelimit"
+	ratelimitclient "github.com/irisnet/irishub/modules/ratelimit/client"
+	ratelimitkeeper "github.com/irisnet/irishub/modules/ratelimit/keeper"
+	ratelimittypes "github.com/irisnet/irishub/modules/ratelimit/types"
+	"github.com/irisnet/irishub/modules/scheduler"
+	schedulerkeeper "github.com/irisnet/irishub/modules/scheduler/keeper"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+	irisservice "github.com/irisnet/irishub/modules/service"
+	irisserviceclient "github.com/irisnet/irishub/modules/service/client"
+	irisservicekeeper "github.com/irisnet/irishub/modules/service/keeper"
+	irisservicetypes "github.com/irisnet/irishub/modules/service/types"
+	"github.com/irisnet/irishub/modules/vesting"
 )
 
 const appName = "IrisApp"
@@ -128,14 +150,15 @@ var (
 		genutil.AppModuleBasic{},
 		bank.AppModuleBasic{},
 		capability.AppModuleBasic{},
-		staking.AppModuleBasic{},
+		stakingModule{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(
+		govModule{gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-		),
+			ratelimitclient.ProposalHandler, irisserviceclient.ProposalHandler,
+		)},
 		params.AppModuleBasic{},
-		crisis.AppModuleBasic{},
+		crisisModule{},
 		slashing.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
@@ -143,12 +166,18 @@ var (
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		guardian.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		memo.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		scheduler.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nft.AppModuleBasic{},
 		htlc.AppModuleBasic{},
 		coinswap.AppModuleBasic{},
-		service.AppModuleBasic{},
+		irisservice.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		random.AppModuleBasic{},
 	)
@@ -168,14 +197,13 @@ var (
 		servicetypes.DepositAccName:    {authtypes.Burner},
 		servicetypes.RequestAccName:    nil,
 		servicetypes.TaxAccName:        {authtypes.Burner},
+		schedulertypes.ModuleName:      nil,
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
 		distrtypes.ModuleName: true,
 	}
-
-	nativeToken tokentypes.Token
 )
 
 // Verify app interface at compile time
@@ -214,38 +242,42 @@ type IrisApp struct {
 	transferKeeper   ibctransferkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
-
-	guardianKeeper guardiankeeper.Keeper
-	tokenKeeper    tokenkeeper.Keeper
-	recordKeeper   recordkeeper.Keeper
-	nftKeeper      nftkeeper.Keeper
-	htlcKeeper     htlckeeper.Keeper
-	coinswapKeeper coinswapkeeper.Keeper
-	serviceKeeper  servicekeeper.Keeper
-	oracleKeeper   oraclekeeper.Keeper
-	randomKeeper   randomkeeper.Keeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCMockKeeper     capabilitykeeper.ScopedKeeper
+
+	guardianKeeper    guardiankeeper.Keeper
+	feeGrantKeeper    feegrantkeeper.Keeper
+	memoKeeper        memokeeper.Keeper
+	rateLimitKeeper   ratelimitkeeper.Keeper
+	schedulerKeeper   schedulerkeeper.Keeper
+	authzKeeper       authzkeeper.Keeper
+	nftTransferKeeper nfttransferkeeper.Keeper
+	tokenKeeper       tokenkeeper.Keeper
+	recordKeeper      recordkeeper.Keeper
+	nftKeeper         nftkeeper.Keeper
+	htlcKeeper        htlckeeper.Keeper
+	coinswapKeeper    coinswapkeeper.Keeper
+	serviceKeeper     servicekeeper.Keeper
+	irisServiceKeeper irisservicekeeper.Keeper
+	oracleKeeper      oraclekeeper.Keeper
+	randomKeeper      randomkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// listeners of the delivered blocks
+	listeners []abciListener
 }
 
 func init() {
 	address.ConfigureBech32Prefix()
-	nativeToken = tokentypes.Token{
-		Symbol:        "iris",
-		Name:          "Irishub staking token",
-		Scale:         6,
-		MinUnit:       "uiris",
-		InitialSupply: 2000000000,
-		MaxSupply:     10000000000,
-		Mintable:      true,
-		Owner:         sdk.AccAddress(crypto.AddressHash([]byte(tokentypes.ModuleName))).String(),
+	if err := SetNativeToken(DefaultNativeToken()); err != nil {
+		panic(err)
 	}
 
 	userHomeDir, err := os.UserHomeDir()
@@ -254,22 +286,6 @@ func init() {
 	}
 
 	DefaultNodeHome = filepath.Join(userHomeDir, ".iris")
-
-	owner, err := sdk.AccAddressFromBech32(nativeToken.Owner)
-	if err != nil {
-		panic(err)
-	}
-
-	tokentypes.SetNativeToken(
-		nativeToken.Symbol,
-		nativeToken.Name,
-		nativeToken.MinUnit,
-		nativeToken.Scale,
-		nativeToken.InitialSupply,
-		nativeToken.MaxSupply,
-		nativeToken.Mintable,
-		owner,
-	)
 }
 
 // NewIrisApp returns a reference to an initialized IrisApp.
@@ -284,6 +300,14 @@ func NewIrisApp(
 	cdc := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 
+	streamer, streamingStopNodeOnError, err := newStreamerFromAppOptions(homePath, appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if streamer != nil {
+		baseAppOptions = append([]func(*baseapp.BaseApp){setStreamingCMS(db, streamer)}, baseAppOptions...)
+	}
+
 	bApp := baseapp.NewBaseApp(appName, logger, db, encodingConfig.TxConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
@@ -296,6 +320,8 @@ func NewIrisApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
+		feegranttypes.StoreKey, memotypes.StoreKey, ratelimittypes.StoreKey, nfttransfertypes.StoreKey,
+		schedulertypes.StoreKey, authztypes.StoreKey, irisservicetypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -311,6 +337,26 @@ func NewIrisApp(
 		memKeys:           memKeys,
 	}
 
+	if streamer != nil {
+		app.listeners = append(app.listeners, abciListener{
+			ABCIListener:    streamer,
+			name:            "streaming",
+			stopNodeOnError: streamingStopNodeOnError,
+		})
+	}
+
+	sqlSink, sqlSinkStopNodeOnError, err := newSQLSinkFromAppOptions(homePath, appOpts, encodingConfig.TxConfig.TxDecoder(), appCodec)
+	if err != nil {
+		panic(err)
+	}
+	if sqlSink != nil {
+		app.listeners = append(app.listeners, abciListener{
+			ABCIListener:    sqlSink,
+			name:            "sql-sink",
+			stopNodeOnError: sqlSinkStopNodeOnError,
+		})
+	}
+
 	app.paramsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 
 	// set the BaseApp's parameter store
@@ -320,6 +366,7 @@ func NewIrisApp(
 	app.capabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.capabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedNFTTransferKeeper := app.capabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 
 	// add keepers
 	app.accountKeeper = authkeeper.NewAccountKeeper(
@@ -331,9 +378,13 @@ func NewIrisApp(
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.accountKeeper, app.bankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
+	app.tokenKeeper = tokenkeeper.NewKeeper(
+		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
+		app.bankKeeper, authtypes.FeeCollectorName,
+	)
 	app.mintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
-		app.accountKeeper, app.bankKeeper, authtypes.FeeCollectorName,
+		app.accountKeeper, app.bankKeeper, app.tokenKeeper, authtypes.FeeCollectorName,
 	)
 	app.distrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.accountKeeper, app.bankKeeper,
@@ -358,29 +409,52 @@ func NewIrisApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.stakingKeeper, scopedIBCKeeper,
 	)
 
+	app.guardianKeeper = guardiankeeper.NewKeeper(
+		appCodec, keys[guardiantypes.StoreKey], app.GetSubspace(guardiantypes.ModuleName),
+	)
+	app.rateLimitKeeper = ratelimitkeeper.NewKeeper(appCodec, keys[ratelimittypes.StoreKey], app.guardianKeeper)
+
+	app.serviceKeeper = servicekeeper.NewKeeper(
+		appCodec, keys[servicetypes.StoreKey], app.accountKeeper, app.bankKeeper,
+		app.GetSubspace(servicetypes.ModuleName), servicetypes.TaxAccName,
+	)
+	app.irisServiceKeeper = irisservicekeeper.NewKeeper(keys[irisservicetypes.StoreKey])
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(ratelimittypes.RouterKey, ratelimit.NewProposalHandler(app.rateLimitKeeper)).
+		AddRoute(irisservicetypes.RouterKey, irisservice.NewProposalHandler(app.serviceKeeper, app.irisServiceKeeper))
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper, app.bankKeeper,
 		&stakingKeeper, govRouter,
 	)
 
-	// Create Transfer Keepers
+	app.nftKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
+
+	// Create Transfer Keepers, the outflow quotas are enforced on the sent packets
 	app.transferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		ratelimit.NewICS4Wrapper(app.ibcKeeper.ChannelKeeper, app.rateLimitKeeper), &app.ibcKeeper.PortKeeper,
 		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.transferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	app.nftTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey],
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		app.nftKeeper, scopedNFTTransferKeeper,
+	)
+	nftTransferModule := nfttransfer.NewAppModule(appCodec, app.nftTransferKeeper)
+
+	// Create static IBC router, add the transfer route wrapped by the rate limits and the nft transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ratelimit.NewIBCModule(transferModule, app.rateLimitKeeper))
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nftTransferModule)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -390,13 +464,9 @@ func NewIrisApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.guardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
-	app.tokenKeeper = tokenkeeper.NewKeeper(
-		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
-		app.bankKeeper, authtypes.FeeCollectorName,
-	)
+	app.feeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegranttypes.StoreKey], app.accountKeeper)
+	app.memoKeeper = memokeeper.NewKeeper(appCodec, keys[memotypes.StoreKey])
 	app.recordKeeper = recordkeeper.NewKeeper(appCodec, keys[recordtypes.StoreKey])
-	app.nftKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
 
 	app.htlcKeeper = htlckeeper.NewKeeper(appCodec, keys[htlctypes.StoreKey], app.accountKeeper, app.bankKeeper)
 
@@ -405,11 +475,6 @@ func NewIrisApp(
 		app.bankKeeper, app.accountKeeper,
 	)
 
-	app.serviceKeeper = servicekeeper.NewKeeper(
-		appCodec, keys[servicetypes.StoreKey], app.accountKeeper, app.bankKeeper,
-		app.GetSubspace(servicetypes.ModuleName), servicetypes.TaxAccName,
-	)
-
 	app.oracleKeeper = oraclekeeper.NewKeeper(
 		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
 		app.serviceKeeper,
@@ -417,6 +482,18 @@ func NewIrisApp(
 
 	app.randomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.bankKeeper, app.serviceKeeper)
 
+	// the scheduled msgs are executed by the Msg service router and checked by the msg decorators of the ante handler
+	schedulerKeeper := schedulerkeeper.NewKeeper(
+		appCodec, keys[schedulertypes.StoreKey], app.GetSubspace(schedulertypes.ModuleName),
+		app.accountKeeper, app.bankKeeper, app.MsgServiceRouter(), interfaceRegistry, authtypes.FeeCollectorName,
+	)
+	app.schedulerKeeper = *schedulerKeeper.SetMsgValidator(NewScheduledMsgValidator(
+		app.bankKeeper, app.tokenKeeper, app.oracleKeeper, app.serviceKeeper, app.irisServiceKeeper,
+		app.guardianKeeper, app.memoKeeper, app.rateLimitKeeper,
+	))
+
+	app.authzKeeper = authzkeeper.NewKeeper(appCodec, keys[authztypes.StoreKey], app.MsgServiceRouter(), interfaceRegistry)
+
 	/****  Module Options ****/
 	var skipGenesisInvariants = false
 	opt := appOpts.Get(crisis.FlagSkipGenesisInvariants)
@@ -447,6 +524,12 @@ func NewIrisApp(
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
 		guardian.NewAppModule(appCodec, app.guardianKeeper),
+		feegrant.NewAppModule(appCodec, app.feeGrantKeeper),
+		memo.NewAppModule(appCodec, app.memoKeeper),
+		ratelimit.NewAppModule(appCodec, app.rateLimitKeeper),
+		scheduler.NewAppModule(appCodec, app.schedulerKeeper),
+		authz.NewAppModule(appCodec, app.authzKeeper),
+		nftTransferModule,
 		token.NewAppModule(appCodec, app.tokenKeeper, app.accountKeeper, app.bankKeeper),
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
@@ -464,7 +547,7 @@ func NewIrisApp(
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
 		slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName,
-		ibchost.ModuleName, htlctypes.ModuleName, randomtypes.ModuleName,
+		ibchost.ModuleName, htlctypes.ModuleName, randomtypes.ModuleName, schedulertypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
@@ -478,13 +561,16 @@ func NewIrisApp(
 	// can do so safely.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
-		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
+		slashingtypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		guardiantypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
+		guardiantypes.ModuleName, tokentypes.ModuleName, minttypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
 		coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
+		feegranttypes.ModuleName, memotypes.ModuleName, ratelimittypes.ModuleName, nfttransfertypes.ModuleName,
+		schedulertypes.ModuleName, authztypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
+	RegisterTokenInvariants(&app.crisisKeeper, app.govKeeper, app.bankKeeper, app.ibcKeeper.ChannelKeeper, app.transferKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
 
@@ -506,6 +592,12 @@ func NewIrisApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
 		guardian.NewAppModule(appCodec, app.guardianKeeper),
+		feegrant.NewAppModule(appCodec, app.feeGrantKeeper),
+		memo.NewAppModule(appCodec, app.memoKeeper),
+		ratelimit.NewAppModule(appCodec, app.rateLimitKeeper),
+		scheduler.NewAppModule(appCodec, app.schedulerKeeper),
+		authz.NewAppModule(appCodec, app.authzKeeper),
+		nftTransferModule,
 		token.NewAppModule(appCodec, app.tokenKeeper, app.accountKeeper, app.bankKeeper),
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
@@ -530,13 +622,22 @@ func NewIrisApp(
 		app.accountKeeper,
 		app.bankKeeper,
 		app.tokenKeeper,
+		app.coinswapKeeper,
 		app.oracleKeeper,
+		app.serviceKeeper,
+		app.irisServiceKeeper,
 		app.guardianKeeper,
+		app.feeGrantKeeper,
+		app.memoKeeper,
+		app.rateLimitKeeper,
+		NewTxRateLimiterFromAppOptions(appOpts),
 		ante.DefaultSigVerificationGasConsumer,
 		encodingConfig.TxConfig.SignModeHandler(),
 	))
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgrades(upgrades)
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -551,10 +652,18 @@ func NewIrisApp(
 		// `loadLatest` is set to true.
 		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
 		app.capabilityKeeper.InitializeAndSeal(ctx)
+
+		// the native token of an initialized chain is loaded from its state
+		if app.LastBlockHeight() > 0 {
+			if err := app.loadNativeToken(ctx); err != nil {
+				tmos.Exit(err.Error())
+			}
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
 
 	return app
 }
@@ -585,6 +694,14 @@ func (app *IrisApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
 
+	nativeToken, err := NativeTokenFromGenesis(app.appCodec, genesisState)
+	if err != nil {
+		panic(err)
+	}
+	if err := SetNativeToken(nativeToken); err != nil {
+		panic(err)
+	}
+
 	// add system service at InitChainer, overwrite if it exists
 	var serviceGenState servicetypes.GenesisState
 	app.appCodec.MustUnmarshalJSON(genesisState[servicetypes.ModuleName], &serviceGenState)
@@ -736,7 +853,9 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(htlctypes.ModuleName)
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(schedulertypes.ModuleName)
 
 	return paramsKeeper
 }
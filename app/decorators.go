@@ -1,20 +1,99 @@
 package app
 
 import (
+	"encoding/hex"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 
 	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	servicekeeper "github.com/irisnet/irismod/modules/service/keeper"
 	servicetypes "github.com/irisnet/irismod/modules/service/types"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	authztypes "github.com/irisnet/irishub/modules/authz/types"
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	memokeeper "github.com/irisnet/irishub/modules/memo/keeper"
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
+	nfttransfertypes "github.com/irisnet/irishub/modules/nfttransfer/types"
+	ratelimitkeeper "github.com/irisnet/irishub/modules/ratelimit/keeper"
+	irisservicekeeper "github.com/irisnet/irishub/modules/service/keeper"
+	"github.com/irisnet/irishub/msgrouter"
 )
 
+// msgsTx wraps msgs so that they can be checked by the decorators checking the msgs of the txs
+type msgsTx struct {
+	msgs []sdk.Msg
+	memo string
+}
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx msgsTx) ValidateBasic() error { return nil }
+func (tx msgsTx) GetMemo() string      { return tx.memo }
+
+// ValidateMsgsDecorator runs the decorators checking the msgs of the txs on the requests of the service msgs
+// and on the msgs executed on behalf of the granters by MsgExec, which are not seen by the decorators otherwise
+type ValidateMsgsDecorator struct {
+	handler sdk.AnteHandler
+}
+
+// NewValidateMsgsDecorator returns an instance of ValidateMsgsDecorator running the given decorators
+func NewValidateMsgsDecorator(decorators ...sdk.AnteDecorator) ValidateMsgsDecorator {
+	return ValidateMsgsDecorator{
+		handler: sdk.ChainAnteDecorators(decorators...),
+	}
+}
+
+// AnteHandle checks the transaction
+func (vmd ValidateMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs, err := flattenMsgs(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	var memo string
+	if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+		memo = memoTx.GetMemo()
+	}
+
+	newCtx, err := vmd.handler(ctx, msgsTx{msgs: msgs, memo: memo}, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	return next(newCtx, tx, simulate)
+}
+
+// flattenMsgs replaces the service msgs with their requests and MsgExec with the msgs it executes,
+// which are kept after it so that the decorators also check the MsgExec itself
+func flattenMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	flattened := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		msg = msgrouter.Request(msg)
+		flattened = append(flattened, msg)
+
+		if msg, ok := msg.(*authztypes.MsgExec); ok {
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			execMsgs, err = flattenMsgs(execMsgs)
+			if err != nil {
+				return nil, err
+			}
+			flattened = append(flattened, execMsgs...)
+		}
+	}
+	return flattened, nil
+}
+
 // ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix
+// and the nft classes reserved for the IBC nft vouchers
 type ValidateTokenDecorator struct {
 	tk tokenkeeper.Keeper
 }
@@ -50,33 +129,226 @@ func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 				return ctx, sdkerrors.Wrap(
 					sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
 			}
+		case *nfttypes.MsgIssueDenom:
+			if isVoucherClass(msg.Id) || isClassPath(msg.Name) {
+				return ctx, sdkerrors.Wrap(
+					sdkerrors.ErrInvalidRequest, "can't issue nft classes reserved for the IBC nft vouchers")
+			}
+		case *nfttypes.MsgMintNFT:
+			if isVoucherClass(msg.DenomId) {
+				return ctx, sdkerrors.Wrap(
+					sdkerrors.ErrInvalidRequest, "can't mint nfts of the IBC nft voucher classes")
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMemoRequiredDecorator is responsible for rejecting the bank sends without memo
+// to the accounts requiring memos on incoming transfers
+type ValidateMemoRequiredDecorator struct {
+	mk memokeeper.Keeper
+}
+
+// NewValidateMemoRequiredDecorator returns an instance of ValidateMemoRequiredDecorator
+func NewValidateMemoRequiredDecorator(mk memokeeper.Keeper) ValidateMemoRequiredDecorator {
+	return ValidateMemoRequiredDecorator{
+		mk: mk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vmd ValidateMemoRequiredDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	if len(strings.TrimSpace(memoTx.GetMemo())) > 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	// the service msgs are unwrapped, so that the decorator checks them on its own as well
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msgrouter.Request(msg).(type) {
+		case *banktypes.MsgSend:
+			if err := vmd.validateRecipient(ctx, msg.ToAddress); err != nil {
+				return ctx, err
+			}
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				if err := vmd.validateRecipient(ctx, output.Address); err != nil {
+					return ctx, err
+				}
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (vmd ValidateMemoRequiredDecorator) validateRecipient(ctx sdk.Context, recipient string) error {
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if vmd.mk.IsMemoRequired(ctx, addr) {
+		return sdkerrors.Wrapf(memotypes.ErrMemoRequired, "recipient %s requires a memo", recipient)
+	}
+	return nil
+}
+
+// ValidateTransferQuotaDecorator is responsible for rejecting early the IBC transfers exceeding the outflow quotas.
+// The state is left untouched, the outflow is recorded when the packet is sent, as the ante state is committed
+// even if the transfer fails
+type ValidateTransferQuotaDecorator struct {
+	rk ratelimitkeeper.Keeper
+}
+
+// NewValidateTransferQuotaDecorator returns an instance of ValidateTransferQuotaDecorator
+func NewValidateTransferQuotaDecorator(rk ratelimitkeeper.Keeper) ValidateTransferQuotaDecorator {
+	return ValidateTransferQuotaDecorator{
+		rk: rk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vtqd ValidateTransferQuotaDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if msg, ok := msg.(*ibctransfertypes.MsgTransfer); ok {
+			if err := vtqd.rk.CheckOutflow(ctx, msg.SourceChannel, msg.Token.Denom, msg.Token.Amount); err != nil {
+				return ctx, err
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
 // ValidateServiceDecorator is responsible for checking the permission to execute MsgCallService
-type ValidateServiceDecorator struct{}
+// and rejecting the new bindings of the retired service definitions
+type ValidateServiceDecorator struct {
+	sk  servicekeeper.Keeper
+	ssk irisservicekeeper.Keeper
+	gk  guardiankeeper.Keeper
+}
 
-// NewValidateServiceDecorator returns an instance of ServiceAuthDecorator
-func NewValidateServiceDecorator() ValidateServiceDecorator {
-	return ValidateServiceDecorator{}
+// NewValidateServiceDecorator returns an instance of ValidateServiceDecorator
+func NewValidateServiceDecorator(
+	sk servicekeeper.Keeper, ssk irisservicekeeper.Keeper, gk guardiankeeper.Keeper,
+) ValidateServiceDecorator {
+	return ValidateServiceDecorator{
+		sk:  sk,
+		ssk: ssk,
+		gk:  gk,
+	}
 }
 
 // AnteHandle checks the transaction
 func (vsd ValidateServiceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	for _, msg := range tx.GetMsgs() {
 		switch msg := msg.(type) {
+		case *servicetypes.MsgBindService:
+			if vsd.ssk.IsDefinitionRetired(ctx, msg.ServiceName) {
+				return ctx, sdkerrors.Wrapf(servicetypes.ErrUnknownServiceDefinition, "service definition %s retired", msg.ServiceName)
+			}
+		case *servicetypes.MsgEnableServiceBinding:
+			if vsd.ssk.IsDefinitionRetired(ctx, msg.ServiceName) {
+				return ctx, sdkerrors.Wrapf(servicetypes.ErrUnknownServiceDefinition, "service definition %s retired", msg.ServiceName)
+			}
 		case *servicetypes.MsgCallService:
-			if msg.Repeated {
-				return ctx, sdkerrors.Wrap(
-					sdkerrors.ErrInvalidRequest, "currently does not support to create repeatable service invocation")
+			if !msg.Repeated {
+				continue
+			}
+			if err := vsd.validateRepeated(
+				ctx, len(msg.Providers), msg.ServiceFeeCap, msg.Timeout, msg.RepeatedFrequency, msg.RepeatedTotal,
+			); err != nil {
+				return ctx, err
+			}
+		case *servicetypes.MsgUpdateRequestContext:
+			requestContextID, err := hex.DecodeString(msg.RequestContextId)
+			if err != nil {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			requestContext, found := vsd.sk.GetRequestContext(ctx, requestContextID)
+			if !found || !requestContext.Repeated {
+				continue
+			}
+
+			// zero values leave the corresponding fields of the request context unchanged
+			providers := len(requestContext.Providers)
+			if len(msg.Providers) > 0 {
+				providers = len(msg.Providers)
+			}
+			serviceFeeCap := requestContext.ServiceFeeCap
+			if !msg.ServiceFeeCap.Empty() {
+				serviceFeeCap = msg.ServiceFeeCap
+			}
+			timeout := requestContext.Timeout
+			if msg.Timeout != 0 {
+				timeout = msg.Timeout
+			}
+			repeatedFrequency := requestContext.RepeatedFrequency
+			if msg.RepeatedFrequency != 0 {
+				repeatedFrequency = msg.RepeatedFrequency
+			}
+			repeatedTotal := requestContext.RepeatedTotal
+			if msg.RepeatedTotal != 0 {
+				repeatedTotal = msg.RepeatedTotal
+			}
+
+			if err := vsd.validateRepeated(
+				ctx, providers, serviceFeeCap, timeout, repeatedFrequency, repeatedTotal,
+			); err != nil {
+				return ctx, err
 			}
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
+// validateRepeated checks a repeated service invocation against the limits in the guardian params
+func (vsd ValidateServiceDecorator) validateRepeated(
+	ctx sdk.Context,
+	providers int,
+	serviceFeeCap sdk.Coins,
+	timeout int64,
+	repeatedFrequency uint64,
+	repeatedTotal int64,
+) error {
+	params := vsd.gk.GetParamSet(ctx)
+
+	if repeatedTotal < 1 || uint64(repeatedTotal) > params.MaxRepeatedTotal {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"repeated total [%d] must be between 1 and %d", repeatedTotal, params.MaxRepeatedTotal,
+		)
+	}
+
+	// the service module uses the timeout as the frequency when none is specified
+	if repeatedFrequency == 0 && timeout > 0 {
+		repeatedFrequency = uint64(timeout)
+	}
+	if repeatedFrequency < params.MinRepeatedFrequency {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"repeated frequency [%d] must not be less than %d", repeatedFrequency, params.MinRepeatedFrequency,
+		)
+	}
+
+	totalFee := sdk.NewCoins()
+	batches := sdk.NewInt(repeatedTotal).MulRaw(int64(providers))
+	for _, coin := range serviceFeeCap {
+		totalFee = totalFee.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(batches)))
+	}
+	if !totalFee.IsAllLTE(params.MaxRepeatedFeeCap) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"total service fee cap [%s] of the repeated invocation exceeds %s", totalFee, params.MaxRepeatedFeeCap,
+		)
+	}
+
+	return nil
+}
+
 func containSwapCoin(coins ...sdk.Coin) bool {
 	for _, coin := range coins {
 		if strings.HasPrefix(coin.Denom, coinswaptypes.FormatUniABSPrefix) {
@@ -85,3 +357,14 @@ func containSwapCoin(coins ...sdk.Coin) bool {
 	}
 	return false
 }
+
+// isVoucherClass returns true if the class id has the prefix of the IBC nft voucher classes
+func isVoucherClass(classID string) bool {
+	return strings.HasPrefix(classID, nfttransfertypes.ClassPrefix)
+}
+
+// isClassPath returns true if the name is a class path which names the IBC nft voucher classes
+func isClassPath(name string) bool {
+	trace := nfttransfertypes.ParseClassTrace(name)
+	return trace.Path != "" && trace.Validate() == nil
+}
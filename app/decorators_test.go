@@ -0,0 +1,316 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	authztypes "github.com/irisnet/irishub/modules/authz/types"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	ratelimittypes "github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx testTx) ValidateBasic() error { return nil }
+
+func TestValidateServiceDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	params := guardiantypes.DefaultParams()
+	params.MaxRepeatedTotal = 10
+	params.MinRepeatedFrequency = 20
+	params.MaxRepeatedFeeCap = sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000))
+	app.guardianKeeper.SetParamSet(ctx, params)
+
+	consumer := sdk.AccAddress([]byte("consumer____________")).String()
+	providers := []string{
+		sdk.AccAddress([]byte("provider1___________")).String(),
+		sdk.AccAddress([]byte("provider2___________")).String(),
+	}
+	feeCap := sdk.NewCoins(sdk.NewInt64Coin("uiris", 50))
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"not repeated", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap, 10, false, 0, 0), false},
+		{"within limits", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap, 10, true, 20, 10), false},
+		{"unlimited repeated total", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap, 10, true, 20, -1), true},
+		{"repeated total too large", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap, 10, true, 20, 11), true},
+		{"repeated frequency too small", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap, 10, true, 19, 10), true},
+		{"default frequency too small", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap, 10, true, 0, 10), true},
+		{"total fee cap too large", servicetypes.NewMsgCallService("s", providers, consumer, "", feeCap.Add(sdk.NewInt64Coin("uiris", 1)), 10, true, 20, 10), true},
+	}
+
+	decorator := NewValidateServiceDecorator(app.serviceKeeper, app.irisServiceKeeper, app.guardianKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	for _, tt := range tests {
+		_, err := decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{tt.msg}}, false, next)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+
+	// updating a repeated request context is checked against the merged request context
+	requestContextID := []byte("request-context-id")
+	app.serviceKeeper.SetRequestContext(ctx, requestContextID, servicetypes.RequestContext{
+		Providers:         providers,
+		ServiceFeeCap:     feeCap,
+		Timeout:           10,
+		Repeated:          true,
+		RepeatedFrequency: 20,
+		RepeatedTotal:     10,
+	})
+
+	update := servicetypes.NewMsgUpdateRequestContext(
+		hex.EncodeToString(requestContextID), nil, nil, 0, 0, 5, consumer,
+	)
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{update}}, false, next)
+	require.NoError(t, err)
+
+	update = servicetypes.NewMsgUpdateRequestContext(
+		hex.EncodeToString(requestContextID), nil, nil, 0, 10, 0, consumer,
+	)
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{update}}, false, next)
+	require.Error(t, err)
+
+	// the retired definitions can not be bound or enabled again
+	bind := servicetypes.NewMsgBindService("s", providers[0], feeCap, `{"price":"1uiris"}`, 1, "", providers[0])
+	enable := servicetypes.NewMsgEnableServiceBinding("s", providers[0], nil, providers[0])
+	for _, msg := range []sdk.Msg{bind, enable} {
+		_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{msg}}, false, next)
+		require.NoError(t, err)
+	}
+
+	app.irisServiceKeeper.SetDefinitionRetired(ctx, "s", true)
+	for _, msg := range []sdk.Msg{bind, enable} {
+		_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{msg}}, false, next)
+		require.Error(t, err)
+	}
+}
+
+type testMemoTx struct {
+	testTx
+	memo string
+}
+
+func (tx testMemoTx) GetMemo() string { return tx.memo }
+
+func TestValidateMemoRequiredDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	sender := sdk.AccAddress([]byte("sender______________"))
+	exchange := sdk.AccAddress([]byte("exchange____________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+	app.memoKeeper.SetMemoRequired(ctx, exchange, true)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uiris", 1))
+	multiSend := func(to sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(sender, coins.Add(coins...))},
+			[]banktypes.Output{banktypes.NewOutput(other, coins), banktypes.NewOutput(to, coins)},
+		)
+	}
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		memo    string
+		wantErr bool
+	}{
+		{"send without memo", banktypes.NewMsgSend(sender, exchange, coins), "", true},
+		{"send with blank memo", banktypes.NewMsgSend(sender, exchange, coins), " ", true},
+		{"send with memo", banktypes.NewMsgSend(sender, exchange, coins), "1234", false},
+		{"send to other without memo", banktypes.NewMsgSend(sender, other, coins), "", false},
+		{"multi send without memo", multiSend(exchange), "", true},
+		{"multi send with memo", multiSend(exchange), "1234", false},
+		{"multi send to other without memo", multiSend(other), "", false},
+		{"service msg send without memo", sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: banktypes.NewMsgSend(sender, exchange, coins)}, "", true},
+		{"service msg send with memo", sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: banktypes.NewMsgSend(sender, exchange, coins)}, "1234", false},
+	}
+
+	decorator := NewValidateMemoRequiredDecorator(app.memoKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	for _, tt := range tests {
+		_, err := decorator.AnteHandle(ctx, testMemoTx{testTx: testTx{msgs: []sdk.Msg{tt.msg}}, memo: tt.memo}, false, next)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+
+	// the memo is not required once the exchange opts out
+	app.memoKeeper.SetMemoRequired(ctx, exchange, false)
+	_, err = decorator.AnteHandle(ctx, testMemoTx{testTx: testTx{msgs: []sdk.Msg{banktypes.NewMsgSend(sender, exchange, coins)}}}, false, next)
+	require.NoError(t, err)
+}
+
+func TestValidateTransferQuotaDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	sender := sdk.AccAddress([]byte("sender______________"))
+	app.rateLimitKeeper.SetQuota(ctx, ratelimittypes.NewQuota("channel-0", "uiris", sdk.NewInt(100), sdk.ZeroInt(), time.Hour))
+
+	transfer := func(channelID string, amount int64) sdk.Tx {
+		return testTx{msgs: []sdk.Msg{ibctransfertypes.NewMsgTransfer(
+			ibctransfertypes.PortID, channelID, sdk.NewInt64Coin("uiris", amount), sender, "receiver", clienttypes.NewHeight(0, 100), 0,
+		)}}
+	}
+
+	decorator := NewValidateTransferQuotaDecorator(app.rateLimitKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	require.NoError(t, app.rateLimitKeeper.CheckAndAddOutflow(ctx, "channel-0", 1, "uiris", sdk.NewInt(80)))
+
+	_, err = decorator.AnteHandle(ctx, transfer("channel-0", 30), false, next)
+	require.Error(t, err)
+
+	_, err = decorator.AnteHandle(ctx, transfer("channel-1", 30), false, next)
+	require.NoError(t, err)
+
+	_, err = decorator.AnteHandle(ctx, transfer("channel-0", 20), false, next)
+	require.NoError(t, err)
+
+	// the outflow is only recorded when the packet is sent
+	quota, _ := app.rateLimitKeeper.GetQuota(ctx, "channel-0", "uiris")
+	require.Equal(t, sdk.NewInt(80), app.rateLimitKeeper.GetFlow(ctx, quota).Outflow)
+}
+
+func TestValidateTokenDecoratorVoucherClasses(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"issue class", nfttypes.NewMsgIssueDenom("kitty", "kitty", "", sender), false},
+		{"issue class with a slash in the name", nfttypes.NewMsgIssueDenom("kitty", "cats/dogs", "", sender), false},
+		{"issue voucher class", nfttypes.NewMsgIssueDenom("ibcabc", "kitty", "", sender), true},
+		{"issue class named as a class path", nfttypes.NewMsgIssueDenom("kitty", "nft-transfer/channel-0/kitty", "", sender), true},
+		{"mint nft", nfttypes.NewMsgMintNFT("kitty001", "kitty", "", "", "", sender, sender), false},
+		{"mint voucher", nfttypes.NewMsgMintNFT("kitty001", "ibcabc", "", "", "", sender, sender), true},
+	}
+
+	decorator := NewValidateTokenDecorator(app.tokenKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{tt.msg}}, false, next)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgsDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+	exchange := sdk.AccAddress([]byte("exchange____________"))
+	app.memoKeeper.SetMemoRequired(ctx, exchange, true)
+
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg, err := authztypes.NewMsgExec(grantee, msgs)
+		require.NoError(t, err)
+		return msg
+	}
+	issueClass := nfttypes.NewMsgIssueDenom("kitty", "kitty", "", granter.String())
+	issueVoucherClass := nfttypes.NewMsgIssueDenom("ibcabc", "kitty", "", granter.String())
+	send := banktypes.NewMsgSend(granter, exchange, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		memo    string
+		wantErr bool
+	}{
+		{"issue voucher class", issueVoucherClass, "", true},
+		{"service msg issuing voucher class", sdk.ServiceMsg{MethodName: "/irismod.nft.Msg/IssueDenom", Request: issueVoucherClass}, "", true},
+		{"exec issuing class", exec(issueClass), "", false},
+		{"exec issuing voucher class", exec(issueClass, issueVoucherClass), "", true},
+		{"nested exec issuing voucher class", exec(exec(issueVoucherClass)), "", true},
+		{"exec sending without memo", exec(send), "", true},
+		{"exec sending with memo", exec(send), "1234", false},
+	}
+
+	decorator := NewValidateMsgsDecorator(NewValidateMemoRequiredDecorator(app.memoKeeper), NewValidateTokenDecorator(app.tokenKeeper))
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, testMemoTx{testTx: testTx{msgs: []sdk.Msg{tt.msg}}, memo: tt.memo}, false, next)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
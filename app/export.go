@@ -1,8 +1,12 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"sort"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -18,37 +22,129 @@ import (
 	"github.com/irisnet/irismod/modules/service"
 )
 
+// ExportOptions defines the options of the state export
+type ExportOptions struct {
+	// Height is the height to export the state at, the latest state is exported if it is not positive
+	Height int64
+	// ForZeroHeight prepares the state to start a new chain at height zero
+	ForZeroHeight bool
+	// JailAllowedAddrs are the operator addresses of the jailed validators to unjail
+	JailAllowedAddrs []string
+	// Modules are the modules to export, all the modules are exported if it is empty
+	Modules []string
+	// ExcludedModules are the modules not to export
+	ExcludedModules []string
+}
+
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *IrisApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
 ) (servertypes.ExportedApp, error) {
+	var appState bytes.Buffer
+	exported, err := app.ExportAppState(&appState, ExportOptions{
+		ForZeroHeight:    forZeroHeight,
+		JailAllowedAddrs: jailAllowedAddrs,
+	})
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	exported.AppState = appState.Bytes()
+	return exported, nil
+}
+
+// ExportAppState streams the app state of the exported modules to the writer as a JSON object,
+// only the state of a single module is held in memory at a time. The state at a historical height
+// is exported from a cached view of that version, so the app is only loaded at the latest height.
+// The returned ExportedApp carries everything but the app state.
+func (app *IrisApp) ExportAppState(w io.Writer, opts ExportOptions) (servertypes.ExportedApp, error) {
+	modules, err := app.exportedModules(opts.Modules, opts.ExcludedModules)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	if opts.Height > 0 && opts.Height != app.LastBlockHeight() {
+		ms, err := app.multiStoreAt(opts.Height)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		ctx = sdk.NewContext(ms, tmproto.Header{Height: opts.Height}, true, app.Logger())
+	}
 
 	// We export at last height + 1, because that's the height at which
 	// Tendermint will start InitChain.
-	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
+	height := ctx.BlockHeight() + 1
+	if opts.ForZeroHeight {
 		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		app.prepForZeroHeightGenesis(ctx, opts.JailAllowedAddrs)
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	for i, name := range modules {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		if i > 0 {
+			key = append([]byte(","), key...)
+		}
+		if _, err := w.Write(append(key, ':')); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		// modules without state export nil, which is encoded as null
+		genesis := app.mm.Modules[name].ExportGenesis(ctx, app.appCodec)
+		if genesis == nil {
+			genesis = []byte("null")
+		}
+		if _, err := w.Write(genesis); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+	if _, err := io.WriteString(w, "}"); err != nil {
 		return servertypes.ExportedApp{}, err
 	}
 
 	validators, err := staking.WriteValidators(ctx, app.stakingKeeper)
 	return servertypes.ExportedApp{
-		AppState:        appState,
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
 	}, err
 }
 
+// exportedModules returns the sorted names of the modules to export
+func (app *IrisApp) exportedModules(include, exclude []string) ([]string, error) {
+	for _, name := range append(include, exclude...) {
+		if _, ok := app.mm.Modules[name]; !ok {
+			return nil, fmt.Errorf("unknown module: %s", name)
+		}
+	}
+
+	excluded := make(map[string]bool)
+	for _, name := range exclude {
+		excluded[name] = true
+	}
+
+	if len(include) == 0 {
+		include = app.mm.OrderExportGenesis
+	}
+
+	var modules []string
+	for _, name := range include {
+		if !excluded[name] {
+			excluded[name] = true
+			modules = append(modules, name)
+		}
+	}
+	sort.Strings(modules)
+	return modules, nil
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
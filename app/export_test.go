@@ -0,0 +1,118 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
+)
+
+func TestExportAppState(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	// the memo requirement is only set at height 2
+	addr := sdk.AccAddress([]byte("addr________________"))
+	header := tmproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.memoKeeper.SetMemoRequired(app.BaseApp.NewContext(false, header), addr, true)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	exportMemo := func(height int64) memotypes.GenesisState {
+		var buf bytes.Buffer
+		exported, err := app.ExportAppState(&buf, ExportOptions{
+			Height:          height,
+			Modules:         []string{memotypes.ModuleName, banktypes.ModuleName},
+			ExcludedModules: []string{banktypes.ModuleName},
+		})
+		require.NoError(t, err)
+		require.Nil(t, exported.AppState)
+		require.Equal(t, height+1, exported.Height)
+
+		var appState GenesisState
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &appState))
+		require.Len(t, appState, 1)
+
+		var memoState memotypes.GenesisState
+		app.appCodec.MustUnmarshalJSON(appState[memotypes.ModuleName], &memoState)
+		return memoState
+	}
+
+	require.Equal(t, []string{addr.String()}, exportMemo(2).MemoRequiredAddresses)
+	require.Empty(t, exportMemo(1).MemoRequiredAddresses)
+
+	_, err = app.ExportAppState(&bytes.Buffer{}, ExportOptions{Modules: []string{"unknown"}})
+	require.Error(t, err)
+
+	// the full export contains the state of all the modules
+	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+	require.Len(t, appState, len(app.mm.Modules))
+}
+
+func TestExportPeriodicVestingAccount(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))
+	periods := vestingtypes.Periods{{Length: 3600, Amount: amount}, {Length: 3600, Amount: amount}}
+
+	header := tmproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	baseAccount := authtypes.NewBaseAccountWithAddress(addr)
+	app.accountKeeper.SetAccount(ctx, vestingtypes.NewPeriodicVestingAccount(baseAccount, amount.Add(amount...), 1625204910, periods))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	var buf bytes.Buffer
+	_, err = app.ExportAppState(&buf, ExportOptions{Modules: []string{authtypes.ModuleName}})
+	require.NoError(t, err)
+
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &appState))
+
+	var authState authtypes.GenesisState
+	app.appCodec.MustUnmarshalJSON(appState[authtypes.ModuleName], &authState)
+	accounts, err := authtypes.UnpackAccounts(authState.Accounts)
+	require.NoError(t, err)
+
+	var found bool
+	for _, account := range accounts {
+		if account, ok := account.(*vestingtypes.PeriodicVestingAccount); ok && account.GetAddress().Equals(addr) {
+			require.Equal(t, periods.String(), vestingtypes.Periods(account.VestingPeriods).String())
+			require.Equal(t, int64(1625204910+7200), account.EndTime)
+			found = true
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,309 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	feegrantkeeper "github.com/irisnet/irishub/modules/feegrant/keeper"
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+// MempoolFeeDecorator checks if the transaction's fee is at least as large as the minimum gas fee.
+// The minimum gas prices of a message are looked up in the msg min gas prices of the guardian params,
+// falling back to the local validator's minimum gas prices, and the highest ones of the messages apply.
+// The fees paid in the fee denoms of the guardian params are valued in the standard denom by the coinswap pools.
+// Note this only applies when ctx.CheckTx = true
+type MempoolFeeDecorator struct {
+	ck coinswapkeeper.Keeper
+	gk guardiankeeper.Keeper
+}
+
+// NewMempoolFeeDecorator returns an instance of MempoolFeeDecorator
+func NewMempoolFeeDecorator(ck coinswapkeeper.Keeper, gk guardiankeeper.Keeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{
+		ck: ck,
+		gk: gk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		// the msgs executed by MsgExec are priced as well, so that wrapping them doesn't lower the prices
+		msgs, err := flattenMsgs(feeTx.GetMsgs())
+		if err != nil {
+			return ctx, err
+		}
+
+		minGasPrices := msgMinGasPrices(ctx.MinGasPrices(), mfd.gk.GetParamSet(ctx), msgs)
+		if !minGasPrices.IsZero() {
+			requiredFees := gasFees(minGasPrices, feeTx.GetGas())
+
+			feeCoins, _ := convertFees(ctx, mfd.ck, mfd.gk, feeTx.GetFee())
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// msgMinGasPrices returns the highest minimum gas prices per denom of the given messages
+func msgMinGasPrices(localMinGasPrices sdk.DecCoins, params guardiantypes.Params, msgs []sdk.Msg) sdk.DecCoins {
+	if len(msgs) == 0 {
+		return localMinGasPrices
+	}
+
+	minGasPrices := sdk.NewDecCoins()
+	for _, msg := range msgs {
+		prices, ok := params.MinGasPricesOf(msgrouter.TypeURL(msg))
+		if !ok {
+			prices = localMinGasPrices
+		}
+
+		for _, price := range prices {
+			if amt := minGasPrices.AmountOf(price.Denom); price.Amount.GT(amt) {
+				minGasPrices = minGasPrices.Add(sdk.NewDecCoinFromDec(price.Denom, price.Amount.Sub(amt)))
+			}
+		}
+	}
+
+	return minGasPrices
+}
+
+// gasFees returns the fees of the gas limit at the given minimum gas prices, where fee = ceil(minGasPrice * gasLimit)
+func gasFees(minGasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	fees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdk.NewDec(int64(gasLimit))
+	for i, gp := range minGasPrices {
+		fees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+	return fees
+}
+
+// ValidateScheduleFeeDecorator checks if the fee of the scheduled msgs is at least as large as their minimum
+// gas fee. Unlike the fees of the txs, the scheduled msgs are priced by the msg min gas prices of the guardian
+// params only, as the local validator's minimum gas prices differ between the nodes executing them
+type ValidateScheduleFeeDecorator struct {
+	gk guardiankeeper.Keeper
+}
+
+// NewValidateScheduleFeeDecorator returns an instance of ValidateScheduleFeeDecorator
+func NewValidateScheduleFeeDecorator(gk guardiankeeper.Keeper) ValidateScheduleFeeDecorator {
+	return ValidateScheduleFeeDecorator{
+		gk: gk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vsfd ValidateScheduleFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msg, ok := msg.(*schedulertypes.MsgSchedule)
+		if !ok {
+			continue
+		}
+
+		scheduled, err := msgrouter.UnpackMsgs(msg.Msgs)
+		if err != nil {
+			return ctx, err
+		}
+		if scheduled, err = flattenMsgs(scheduled); err != nil {
+			return ctx, err
+		}
+
+		minGasPrices := msgMinGasPrices(nil, vsfd.gk.GetParamSet(ctx), scheduled)
+		if minGasPrices.IsZero() {
+			continue
+		}
+		if fees := gasFees(minGasPrices, msg.GasLimit); !msg.Fee.IsAnyGTE(fees) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient schedule fee; got: %s required: %s", msg.Fee, fees)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator deducts fees from the fee payer of the tx, or from the fee granter if the tx
+// sets one and the granter has granted an allowance to the fee payer. The fees paid in the fee denoms
+// of the guardian params are swapped into the standard denom before being sent to the fee collector
+type DeductFeeDecorator struct {
+	ak authkeeper.AccountKeeper
+	bk bankkeeper.Keeper
+	ck coinswapkeeper.Keeper
+	gk guardiankeeper.Keeper
+	fk feegrantkeeper.Keeper
+}
+
+// NewDeductFeeDecorator returns an instance of DeductFeeDecorator
+func NewDeductFeeDecorator(
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	ck coinswapkeeper.Keeper,
+	gk guardiankeeper.Keeper,
+	fk feegrantkeeper.Keeper,
+) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		ak: ak,
+		bk: bk,
+		ck: ck,
+		gk: gk,
+		fk: fk,
+	}
+}
+
+// AnteHandle deducts the fees of the transaction
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := dfd.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fees := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	deductFeesFrom := feePayer
+
+	// the fees are deducted from the granter if the allowance granted to the fee payer accepts them
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil && !feeGranter.Equals(feePayer) {
+		if err := dfd.fk.UseGrantedFees(ctx, feeGranter, feePayer, fees, tx.GetMsgs()); err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+		}
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if fees.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// the fees which can't be swapped are neither swapped nor deducted, so that the fee collector only
+	// receives the standard denom for the fee denoms, as long as the other fees are worth something
+	convertedFees, unconvertedFees := convertFees(ctx, dfd.ck, dfd.gk, fees)
+	if convertedFees.IsZero() {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "none of the fees %s can be swapped into the standard denom", fees)
+	}
+
+	// swap the fees paid in the fee denoms into the standard denom
+	standardDenom := dfd.ck.GetStandardDenom(ctx)
+	params := dfd.gk.GetParamSet(ctx)
+	for _, fee := range fees {
+		if fee.Denom == standardDenom || !params.IsFeeDenom(fee.Denom) || unconvertedFees.AmountOf(fee.Denom).IsPositive() {
+			continue
+		}
+
+		boughtAmt, err := feeSwapOutput(ctx, dfd.ck, params.MaxFeeSlippage, params.MinFeePoolLiquidity, fee)
+		if err != nil {
+			return ctx, err
+		}
+
+		if err := dfd.ck.Swap(ctx, &coinswaptypes.MsgSwapOrder{
+			Input:      coinswaptypes.Input{Address: deductFeesFrom.String(), Coin: fee},
+			Output:     coinswaptypes.Output{Address: deductFeesFrom.String(), Coin: sdk.NewCoin(standardDenom, boughtAmt)},
+			IsBuyOrder: false,
+		}); err != nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to swap fee %s: %s", fee, err)
+		}
+	}
+
+	if err := ante.DeductFees(dfd.bk, ctx, deductFeesFromAcc, convertedFees); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// convertFees values the fees paid in the fee denoms of the guardian params in the standard denom.
+// The fees which can't be swapped, as the pool is missing or too thin or the slippage is too high,
+// are returned apart without value, so that the other fees may still cover the minimum gas fee
+func convertFees(
+	ctx sdk.Context, ck coinswapkeeper.Keeper, gk guardiankeeper.Keeper, fees sdk.Coins,
+) (convertedFees, unconvertedFees sdk.Coins) {
+	params := gk.GetParamSet(ctx)
+	if len(params.FeeDenoms) == 0 {
+		return fees, sdk.NewCoins()
+	}
+
+	standardDenom := ck.GetStandardDenom(ctx)
+	convertedFees, unconvertedFees = sdk.NewCoins(), sdk.NewCoins()
+	for _, fee := range fees {
+		if fee.Denom == standardDenom || !params.IsFeeDenom(fee.Denom) {
+			convertedFees = convertedFees.Add(fee)
+			continue
+		}
+
+		boughtAmt, err := feeSwapOutput(ctx, ck, params.MaxFeeSlippage, params.MinFeePoolLiquidity, fee)
+		if err != nil {
+			unconvertedFees = unconvertedFees.Add(fee)
+			continue
+		}
+		convertedFees = convertedFees.Add(sdk.NewCoin(standardDenom, boughtAmt))
+	}
+
+	return convertedFees, unconvertedFees
+}
+
+// feeSwapOutput returns the amount of the standard denom bought by swapping the given fee,
+// it fails if the pool liquidity is too thin or the slippage exceeds the maximum
+func feeSwapOutput(
+	ctx sdk.Context,
+	ck coinswapkeeper.Keeper,
+	maxSlippage sdk.Dec,
+	minLiquidity sdk.Int,
+	fee sdk.Coin,
+) (sdk.Int, error) {
+	standardDenom := ck.GetStandardDenom(ctx)
+	uniDenom, err := ck.GetUniDenomFromDenoms(ctx, fee.Denom, standardDenom)
+	if err != nil {
+		return sdk.ZeroInt(), sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	reservePool := ck.GetReservePool(ctx, uniDenom)
+	inputReserve := reservePool.AmountOf(fee.Denom)
+	outputReserve := reservePool.AmountOf(standardDenom)
+	if !inputReserve.IsPositive() || outputReserve.LT(minLiquidity) || !outputReserve.IsPositive() {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"liquidity of the pool %s is too thin to pay fees in %s", uniDenom, fee.Denom,
+		)
+	}
+
+	boughtAmt := coinswapkeeper.GetInputPrice(fee.Amount, inputReserve, outputReserve, ck.GetParams(ctx).Fee)
+
+	// the slippage is measured against the spot price of the pool, including the swap fee
+	spotAmt := fee.Amount.ToDec().Mul(outputReserve.ToDec()).Quo(inputReserve.ToDec())
+	if boughtAmt.ToDec().LT(spotAmt.Mul(sdk.OneDec().Sub(maxSlippage))) {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"slippage of swapping fee %s exceeds %s", fee, maxSlippage,
+		)
+	}
+	if !boughtAmt.IsPositive() {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s is too small to be swapped", fee)
+	}
+
+	return boughtAmt, nil
+}
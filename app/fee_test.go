@@ -0,0 +1,267 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	authztypes "github.com/irisnet/irishub/modules/authz/types"
+	feegranttypes "github.com/irisnet/irishub/modules/feegrant/types"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+)
+
+type testFeeTx struct {
+	testTx
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx testFeeTx) GetGas() uint64             { return 100000 }
+func (tx testFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx testFeeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx testFeeTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+func TestFeeDecorators(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	standardDenom := app.coinswapKeeper.GetStandardDenom(ctx)
+
+	// create a btc pool with 1:1 price
+	provider := sdk.AccAddress([]byte("liquidity-provider__"))
+	payer := sdk.AccAddress([]byte("fee-payer___________"))
+	app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, provider))
+	app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, payer))
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, provider, sdk.NewCoins(
+		sdk.NewInt64Coin("btc", 10000000000), sdk.NewInt64Coin(standardDenom, 10000000000),
+	)))
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, payer, sdk.NewCoins(sdk.NewInt64Coin("btc", 1000000))))
+	_, err = app.coinswapKeeper.AddLiquidity(ctx, &coinswaptypes.MsgAddLiquidity{
+		MaxToken:         sdk.NewInt64Coin("btc", 2000000000),
+		ExactStandardAmt: sdk.NewInt(2000000000),
+		MinLiquidity:     sdk.OneInt(),
+		Sender:           provider.String(),
+	})
+	require.NoError(t, err)
+
+	params := app.guardianKeeper.GetParamSet(ctx)
+	params.FeeDenoms = []string{"btc"}
+	params.MaxFeeSlippage = sdk.NewDecWithPrec(1, 2)
+	params.MinFeePoolLiquidity = sdk.NewInt(1000000000)
+	app.guardianKeeper.SetParamSet(ctx, params)
+
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	mfd := NewMempoolFeeDecorator(app.coinswapKeeper, app.guardianKeeper)
+	dfd := NewDeductFeeDecorator(app.accountKeeper, app.bankKeeper, app.coinswapKeeper, app.guardianKeeper, app.feeGrantKeeper)
+
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(standardDenom, sdk.NewDecWithPrec(2, 1))))
+
+	// 20000btc is worth less than the required 20000 standard denom after the swap fee
+	_, err = mfd.AnteHandle(checkCtx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 20000)), payer: payer}, false, next)
+	require.Error(t, err)
+
+	_, err = mfd.AnteHandle(checkCtx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 21000)), payer: payer}, false, next)
+	require.NoError(t, err)
+
+	// denoms out of the allowlist are not valued in the standard denom
+	_, err = mfd.AnteHandle(checkCtx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("eth", 21000)), payer: payer}, false, next)
+	require.Error(t, err)
+
+	// slippage of swapping 1% of the pool exceeds 1%
+	_, err = dfd.AnteHandle(ctx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 20000000)), payer: payer}, false, next)
+	require.Error(t, err)
+
+	// fee collector receives the standard denom
+	feeCollector := app.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := app.bankKeeper.GetBalance(ctx, feeCollector, standardDenom)
+	_, err = dfd.AnteHandle(ctx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 21000)), payer: payer}, false, next)
+	require.NoError(t, err)
+	require.True(t, app.bankKeeper.GetBalance(ctx, feeCollector, standardDenom).Amount.GT(collected.Amount))
+	require.True(t, app.bankKeeper.GetBalance(ctx, feeCollector, "btc").IsZero())
+	require.Equal(t, int64(1000000-21000), app.bankKeeper.GetBalance(ctx, payer, "btc").Amount.Int64())
+	require.True(t, app.bankKeeper.GetBalance(ctx, payer, standardDenom).IsZero())
+
+	// fees are deducted from the granter within the allowance
+	granter := sdk.AccAddress([]byte("fee-granter_________"))
+	app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, granter))
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("btc", 1000000))))
+
+	grantedTx := testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 21000)), payer: payer, granter: granter}
+	_, err = dfd.AnteHandle(ctx, grantedTx, false, next)
+	require.Error(t, err)
+
+	require.NoError(t, app.feeGrantKeeper.GrantAllowance(ctx, granter, payer, &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("btc", 30000)),
+	}))
+	_, err = dfd.AnteHandle(ctx, grantedTx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000000-21000), app.bankKeeper.GetBalance(ctx, granter, "btc").Amount.Int64())
+	require.Equal(t, int64(1000000-21000), app.bankKeeper.GetBalance(ctx, payer, "btc").Amount.Int64())
+
+	// the remaining allowance can not cover the fees
+	_, err = dfd.AnteHandle(ctx, grantedTx, false, next)
+	require.Error(t, err)
+
+	// pool liquidity is too thin
+	params.MinFeePoolLiquidity = sdk.NewInt(3000000000)
+	app.guardianKeeper.SetParamSet(ctx, params)
+	_, err = dfd.AnteHandle(ctx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 21000)), payer: payer}, false, next)
+	require.Error(t, err)
+
+	// the fees which can't be swapped are skipped while the other fees cover the minimum gas fee
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, payer, sdk.NewCoins(
+		sdk.NewInt64Coin("btc", 1000000), sdk.NewInt64Coin(standardDenom, 1000000),
+	)))
+	mixedTx := testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 21000), sdk.NewInt64Coin(standardDenom, 20000)), payer: payer}
+	_, err = mfd.AnteHandle(checkCtx, mixedTx, false, next)
+	require.NoError(t, err)
+
+	_, err = dfd.AnteHandle(ctx, mixedTx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000000), app.bankKeeper.GetBalance(ctx, payer, "btc").Amount.Int64())
+	require.Equal(t, int64(1000000-20000), app.bankKeeper.GetBalance(ctx, payer, standardDenom).Amount.Int64())
+	for _, coin := range app.bankKeeper.GetAllBalances(ctx, feeCollector) {
+		require.Equal(t, standardDenom, coin.Denom)
+	}
+
+	// the skipped fees are not valued in the standard denom
+	_, err = mfd.AnteHandle(checkCtx, testFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("btc", 21000), sdk.NewInt64Coin(standardDenom, 19999)), payer: payer}, false, next)
+	require.Error(t, err)
+}
+
+func TestMsgMinGasPrices(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := sdk.AccAddress([]byte("sender______________"))
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))},
+		[]banktypes.Output{banktypes.NewOutput(addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))},
+	)
+
+	delegate := stakingtypes.NewMsgDelegate(addr, sdk.ValAddress(addr), sdk.NewInt64Coin("uiris", 1))
+	exec, err := authztypes.NewMsgExec(addr, []sdk.Msg{sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/MultiSend", Request: multiSend}})
+	require.NoError(t, err)
+
+	params := app.guardianKeeper.GetParamSet(ctx)
+	params.MsgMinGasPrices = []guardiantypes.MsgMinGasPrice{
+		guardiantypes.NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(1, 2)))),
+		guardiantypes.NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgMultiSend", sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(5, 1)))),
+	}
+	app.guardianKeeper.SetParamSet(ctx, params)
+
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	mfd := NewMempoolFeeDecorator(app.coinswapKeeper, app.guardianKeeper)
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1))))
+
+	tests := []struct {
+		name    string
+		msgs    []sdk.Msg
+		fee     sdk.Coins
+		wantErr bool
+	}{
+		{"table price", []sdk.Msg{send}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000)), false},
+		{"below table price", []sdk.Msg{send}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 999)), true},
+		{"local price", []sdk.Msg{delegate}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000)), true},
+		{"local price paid", []sdk.Msg{delegate}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000)), false},
+		{"highest price of the msgs", []sdk.Msg{send, multiSend}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000)), true},
+		{"highest price of the msgs paid", []sdk.Msg{send, multiSend}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 50000)), false},
+		{"price of the executed msgs", []sdk.Msg{exec}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000)), true},
+		{"price of the executed msgs paid", []sdk.Msg{exec}, sdk.NewCoins(sdk.NewInt64Coin("uiris", 50000)), false},
+	}
+
+	for _, tt := range tests {
+		_, err := mfd.AnteHandle(checkCtx, testFeeTx{testTx: testTx{msgs: tt.msgs}, fee: tt.fee, payer: addr}, false, next)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+
+	// the table applies even if the local validator sets no minimum gas prices
+	_, err = mfd.AnteHandle(ctx.WithIsCheckTx(true), testFeeTx{testTx: testTx{msgs: []sdk.Msg{send}}, payer: addr}, false, next)
+	require.Error(t, err)
+}
+
+func TestValidateScheduleFeeDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := sdk.AccAddress([]byte("sender______________"))
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	delegate := stakingtypes.NewMsgDelegate(addr, sdk.ValAddress(addr), sdk.NewInt64Coin("uiris", 1))
+
+	params := app.guardianKeeper.GetParamSet(ctx)
+	params.MsgMinGasPrices = []guardiantypes.MsgMinGasPrice{
+		guardiantypes.NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(1, 2)))),
+	}
+	app.guardianKeeper.SetParamSet(ctx, params)
+
+	schedule := func(msg sdk.Msg, fee int64) sdk.Msg {
+		msgSchedule, err := schedulertypes.NewMsgSchedule(addr, []sdk.Msg{msg}, 100, nil, 200000, sdk.NewCoins(sdk.NewInt64Coin("uiris", fee)))
+		require.NoError(t, err)
+		return msgSchedule
+	}
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"table price paid", schedule(send, 2000), false},
+		{"below table price", schedule(send, 1999), true},
+		{"no table price", schedule(delegate, 1), false},
+		{"not a schedule", send, false},
+	}
+
+	// the local minimum gas prices never apply to the scheduled msgs
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDec(1))))
+	decorator := NewValidateScheduleFeeDecorator(app.guardianKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	for _, tt := range tests {
+		for _, ctx := range []sdk.Context{ctx, checkCtx} {
+			_, err := decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{tt.msg}}, false, next)
+			if tt.wantErr {
+				require.Error(t, err, tt.name)
+			} else {
+				require.NoError(t, err, tt.name)
+			}
+		}
+	}
+}
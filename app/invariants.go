@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransferkeeper "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channelkeeper "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// InvariantModuleName is the module name of the app-level invariant routes
+const InvariantModuleName = "irishub"
+
+// RegisterTokenInvariants registers the invariants of the token restrictions enforced by ValidateTokenDecorator
+func RegisterTokenInvariants(
+	ir sdk.InvariantRegistry,
+	gk govkeeper.Keeper,
+	bk bankkeeper.Keeper,
+	ck channelkeeper.Keeper,
+	tk ibctransferkeeper.Keeper,
+) {
+	ir.RegisterRoute(InvariantModuleName, "lp-gov-deposits", LPGovDepositsInvariant(gk))
+	ir.RegisterRoute(InvariantModuleName, "lp-gov-proposals", LPGovProposalsInvariant(gk))
+	ir.RegisterRoute(InvariantModuleName, "lp-ibc-escrows", LPIBCEscrowsInvariant(bk, ck, tk))
+}
+
+// LPGovDepositsInvariant checks that no gov deposit contains coinswap liquidity tokens
+func LPGovDepositsInvariant(gk govkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		gk.IterateAllDeposits(ctx, func(deposit govtypes.Deposit) bool {
+			if containSwapCoin(deposit.Amount...) {
+				broken = true
+				msg += fmt.Sprintf("\tdeposit of %s to proposal %d contains liquidity tokens: %s\n",
+					deposit.Depositor, deposit.ProposalId, deposit.Amount)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(InvariantModuleName, "lp gov deposits", msg), broken
+	}
+}
+
+// LPGovProposalsInvariant checks that the total deposit of no proposal contains coinswap liquidity tokens
+func LPGovProposalsInvariant(gk govkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		gk.IterateProposals(ctx, func(proposal govtypes.Proposal) bool {
+			if containSwapCoin(proposal.TotalDeposit...) {
+				broken = true
+				msg += fmt.Sprintf("\ttotal deposit of proposal %d contains liquidity tokens: %s\n",
+					proposal.ProposalId, proposal.TotalDeposit)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(InvariantModuleName, "lp gov proposals", msg), broken
+	}
+}
+
+// LPIBCEscrowsInvariant checks that no escrow account of the IBC transfer channels holds coinswap liquidity tokens
+func LPIBCEscrowsInvariant(bk bankkeeper.Keeper, ck channelkeeper.Keeper, tk ibctransferkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		portID := tk.GetPort(ctx)
+		ck.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+			if channel.PortId != portID {
+				return false
+			}
+
+			escrow := ibctransfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId)
+			if balances := bk.GetAllBalances(ctx, escrow); containSwapCoin(balances...) {
+				broken = true
+				msg += fmt.Sprintf("\tescrow account %s of channel %s holds liquidity tokens: %s\n",
+					escrow, channel.ChannelId, balances)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(InvariantModuleName, "lp ibc escrows", msg), broken
+	}
+}
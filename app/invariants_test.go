@@ -0,0 +1,88 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+func TestTokenInvariants(t *testing.T) {
+	app := NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Height: 2})
+	lpCoins := sdk.NewCoins(sdk.NewInt64Coin("swap/uiris", 100))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	var routes []string
+	for _, route := range app.crisisKeeper.Routes() {
+		if route.ModuleName == InvariantModuleName {
+			routes = append(routes, route.Route)
+		}
+	}
+	require.Equal(t, []string{"lp-gov-deposits", "lp-gov-proposals", "lp-ibc-escrows"}, routes)
+
+	depositsInvariant := LPGovDepositsInvariant(app.govKeeper)
+	proposalsInvariant := LPGovProposalsInvariant(app.govKeeper)
+	escrowsInvariant := LPIBCEscrowsInvariant(app.bankKeeper, app.ibcKeeper.ChannelKeeper, app.transferKeeper)
+
+	// the invariants hold without liquidity tokens
+	proposal, err := govtypes.NewProposal(govtypes.NewTextProposal("title", "description"), 1, time.Now(), time.Now())
+	require.NoError(t, err)
+	proposal.TotalDeposit = coins
+	app.govKeeper.SetProposal(ctx, proposal)
+	depositor := sdk.AccAddress([]byte("depositor___________"))
+	app.govKeeper.SetDeposit(ctx, govtypes.NewDeposit(1, depositor, coins))
+
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-0"),
+		[]string{"connection-0"}, ibctransfertypes.Version,
+	)
+	app.ibcKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-0", channel)
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, escrow, coins))
+
+	for _, invariant := range []sdk.Invariant{depositsInvariant, proposalsInvariant, escrowsInvariant} {
+		_, broken := invariant(ctx)
+		require.False(t, broken)
+	}
+
+	// the invariants are broken by liquidity tokens
+	proposal.TotalDeposit = coins.Add(lpCoins...)
+	app.govKeeper.SetProposal(ctx, proposal)
+	_, broken := proposalsInvariant(ctx)
+	require.True(t, broken)
+
+	app.govKeeper.SetDeposit(ctx, govtypes.NewDeposit(1, depositor, lpCoins))
+	msg, broken := depositsInvariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, depositor.String())
+
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, escrow, coins.Add(lpCoins...)))
+	msg, broken = escrowsInvariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, escrow.String())
+
+	// the liquidity tokens of an escrow account of another port are not checked
+	app.ibcKeeper.ChannelKeeper.SetChannel(ctx, "other", "channel-1", channel)
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, escrow, coins))
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, ibctransfertypes.GetEscrowAddress("other", "channel-1"), lpCoins))
+	_, broken = escrowsInvariant(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ABCIListener is notified of the ABCI requests and responses of the delivered blocks
+type ABCIListener interface {
+	ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock)
+	ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx)
+	ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock)
+	// ListenCommit is called once the block is committed
+	ListenCommit(res abci.ResponseCommit) error
+}
+
+// abciListener is a registered ABCIListener
+type abciListener struct {
+	ABCIListener
+
+	name            string
+	stopNodeOnError bool
+}
+
+// BeginBlock implements the ABCI interface, the request and response are passed to the listeners
+func (app *IrisApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.BaseApp.BeginBlock(req)
+	for _, listener := range app.listeners {
+		listener.ListenBeginBlock(req, res)
+	}
+	return res
+}
+
+// DeliverTx implements the ABCI interface, the request and response are passed to the listeners
+func (app *IrisApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	for _, listener := range app.listeners {
+		listener.ListenDeliverTx(req, res)
+	}
+	return res
+}
+
+// EndBlock implements the ABCI interface, the request and response are passed to the listeners
+func (app *IrisApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	for _, listener := range app.listeners {
+		listener.ListenEndBlock(req, res)
+	}
+	return res
+}
+
+// Commit implements the ABCI interface, the listeners are notified once the block is committed
+func (app *IrisApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	for _, listener := range app.listeners {
+		if err := listener.ListenCommit(res); err != nil {
+			if listener.stopNodeOnError {
+				panic(err)
+			}
+			app.Logger().Error("failed to notify the committed block", "listener", listener.name, "err", err)
+		}
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// The module basics below override the default genesis states of the SDK modules
+// so that they are denominated in the min unit of the native token
+
+// stakingModule overrides the default genesis state of the staking module
+type stakingModule struct {
+	staking.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis state of the staking module
+func (stakingModule) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
+	params := stakingtypes.DefaultParams()
+	params.BondDenom = tokentypes.GetNativeToken().MinUnit
+	return cdc.MustMarshalJSON(stakingtypes.NewGenesisState(params, nil, nil))
+}
+
+// crisisModule overrides the default genesis state of the crisis module
+type crisisModule struct {
+	crisis.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis state of the crisis module
+func (crisisModule) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
+	genState := crisistypes.DefaultGenesisState()
+	genState.ConstantFee.Denom = tokentypes.GetNativeToken().MinUnit
+	return cdc.MustMarshalJSON(genState)
+}
+
+// govModule overrides the default genesis state of the gov module
+type govModule struct {
+	gov.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis state of the gov module
+func (govModule) DefaultGenesis(cdc codec.JSONMarshaler) json.RawMessage {
+	genState := govtypes.DefaultGenesisState()
+	genState.DepositParams.MinDeposit = sdk.NewCoins(
+		sdk.NewCoin(tokentypes.GetNativeToken().MinUnit, govtypes.DefaultMinDepositTokens),
+	)
+	return cdc.MustMarshalJSON(genState)
+}
@@ -0,0 +1,134 @@
+package app
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+// DefaultNativeToken returns the native token of the IRIS Hub, it is used by the default
+// genesis state until the native token is loaded from the genesis or the state of the chain
+func DefaultNativeToken() tokentypes.Token {
+	return tokentypes.Token{
+		Symbol:        "iris",
+		Name:          "Irishub staking token",
+		Scale:         6,
+		MinUnit:       "uiris",
+		InitialSupply: 2000000000,
+		MaxSupply:     10000000000,
+		Mintable:      true,
+		Owner:         sdk.AccAddress(crypto.AddressHash([]byte(tokentypes.ModuleName))).String(),
+	}
+}
+
+// SetNativeToken validates the given token and sets it as the native token of the app
+func SetNativeToken(token tokentypes.Token) error {
+	if err := tokentypes.ValidateToken(token); err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(token.Owner)
+	if err != nil {
+		return err
+	}
+
+	tokentypes.SetNativeToken(
+		token.Symbol,
+		token.Name,
+		token.MinUnit,
+		token.Scale,
+		token.InitialSupply,
+		token.MaxSupply,
+		token.Mintable,
+		owner,
+	)
+	return nil
+}
+
+// NativeTokenFromGenesis returns the native token defined by the genesis state, which is the
+// token of the token genesis whose min unit is the bond denom of the staking genesis
+func NativeTokenFromGenesis(cdc codec.JSONMarshaler, genesisState GenesisState) (tokentypes.Token, error) {
+	var stakingGenState stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenState); err != nil {
+		return tokentypes.Token{}, err
+	}
+
+	var tokenGenState tokentypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[tokentypes.ModuleName], &tokenGenState); err != nil {
+		return tokentypes.Token{}, err
+	}
+
+	var mintGenState minttypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[minttypes.ModuleName], &mintGenState); err != nil {
+		return tokentypes.Token{}, err
+	}
+
+	bondDenom := stakingGenState.Params.BondDenom
+	for _, token := range tokenGenState.Tokens {
+		if token.MinUnit == bondDenom {
+			return token, validateNativeToken(token, bondDenom, mintGenState.Params)
+		}
+	}
+	return tokentypes.Token{}, fmt.Errorf("no token found for the bond denom %s", bondDenom)
+}
+
+// loadNativeToken sets the native token from the state of the chain. It is a no-op on a chain started
+// before the mint schedules, the native token is then loaded once the v1.1 upgrade migrates them
+func (app *IrisApp) loadNativeToken(ctx sdk.Context) error {
+	if !app.mintKeeper.HasSchedules(ctx) {
+		return nil
+	}
+
+	bondDenom := app.stakingKeeper.BondDenom(ctx)
+	tokenI, err := app.tokenKeeper.GetToken(ctx, bondDenom)
+	if err != nil {
+		return fmt.Errorf("no token found for the bond denom %s: %w", bondDenom, err)
+	}
+
+	token, ok := tokenI.(*tokentypes.Token)
+	if !ok {
+		return fmt.Errorf("unexpected token type %T", tokenI)
+	}
+	if err := validateNativeToken(*token, bondDenom, app.mintKeeper.GetParamSet(ctx)); err != nil {
+		return err
+	}
+	return SetNativeToken(*token)
+}
+
+// ApplySnapshotChunk implements the ABCI interface. The app of a state synced node is constructed on
+// an empty state, so that the native token is loaded from the state once the final chunk is restored
+func (app *IrisApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
+	res := app.BaseApp.ApplySnapshotChunk(req)
+	if res.Result != abci.ResponseApplySnapshotChunk_ACCEPT || app.LastBlockHeight() == 0 {
+		return res
+	}
+
+	ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+	if err := app.loadNativeToken(ctx); err != nil {
+		app.Logger().Error("failed to load the native token from the restored state", "err", err)
+		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+	return res
+}
+
+// validateNativeToken checks that the min unit of the native token is the bond denom
+// and is minted by the mint module
+func validateNativeToken(token tokentypes.Token, bondDenom string, mintParams minttypes.Params) error {
+	if token.MinUnit != bondDenom {
+		return fmt.Errorf("the min unit %s of the native token does not match the bond denom %s", token.MinUnit, bondDenom)
+	}
+	if _, found := mintParams.GetSchedule(token.MinUnit); !found {
+		return fmt.Errorf("no mint schedule found for the native token %s", token.MinUnit)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestNativeTokenFromGenesis(t *testing.T) {
+	cdc := MakeEncodingConfig().Marshaler
+
+	token, err := NativeTokenFromGenesis(cdc, NewDefaultGenesisState())
+	require.NoError(t, err)
+	require.Equal(t, DefaultNativeToken(), token)
+
+	// the bond denom must be the min unit of a token
+	genesisState := NewDefaultGenesisState()
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenState)
+	stakingGenState.Params.BondDenom = "stake"
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+	_, err = NativeTokenFromGenesis(cdc, genesisState)
+	require.Error(t, err)
+
+	// the native token must be minted by the mint module
+	genesisState = NewDefaultGenesisState()
+	var mintGenState minttypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[minttypes.ModuleName], &mintGenState)
+	mintGenState.Params.Schedules[0].Denom = "stake"
+	genesisState[minttypes.ModuleName] = cdc.MustMarshalJSON(&mintGenState)
+	_, err = NativeTokenFromGenesis(cdc, genesisState)
+	require.Error(t, err)
+}
+
+func TestCustomNativeToken(t *testing.T) {
+	defer func() { require.NoError(t, SetNativeToken(DefaultNativeToken())) }()
+
+	db := dbm.NewMemDB()
+	newApp := func() *IrisApp {
+		return NewIrisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	}
+
+	// the native token of the fork is defined by its genesis only
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	stateBytes = bytes.ReplaceAll(stateBytes, []byte(`"uiris"`), []byte(`"ufork"`))
+	stateBytes = bytes.ReplaceAll(stateBytes, []byte(`"iris"`), []byte(`"fork"`))
+
+	app := newApp()
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+	require.Equal(t, "fork", tokentypes.GetNativeToken().Symbol)
+	require.Equal(t, "ufork", tokentypes.GetNativeToken().MinUnit)
+
+	// the native token is loaded from the state on restart
+	require.NoError(t, SetNativeToken(DefaultNativeToken()))
+	newApp()
+	require.Equal(t, "ufork", tokentypes.GetNativeToken().MinUnit)
+}
+
+func TestNativeTokenAfterStateSync(t *testing.T) {
+	defer func() { require.NoError(t, SetNativeToken(DefaultNativeToken())) }()
+
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	newApp := func(name string, opts ...func(*baseapp.BaseApp)) *IrisApp {
+		snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), dir+"/"+name)
+		require.NoError(t, err)
+		opts = append(opts, baseapp.SetSnapshotStore(snapshotStore))
+		return NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, opts...)
+	}
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	stateBytes = bytes.ReplaceAll(stateBytes, []byte(`"uiris"`), []byte(`"ufork"`))
+	stateBytes = bytes.ReplaceAll(stateBytes, []byte(`"iris"`), []byte(`"fork"`))
+
+	source := newApp("source", baseapp.SetSnapshotInterval(1))
+	source.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	source.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	source.EndBlock(abci.RequestEndBlock{Height: 1})
+	source.Commit()
+
+	// the snapshot is taken in the background
+	var snapshot *abci.Snapshot
+	require.Eventually(t, func() bool {
+		if res := source.ListSnapshots(abci.RequestListSnapshots{}); len(res.Snapshots) > 0 {
+			snapshot = res.Snapshots[0]
+			return true
+		}
+		return false
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the native token of the synced node is loaded from the restored state
+	require.NoError(t, SetNativeToken(DefaultNativeToken()))
+	target := newApp("target")
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, target.OfferSnapshot(abci.RequestOfferSnapshot{
+		Snapshot: snapshot, AppHash: source.LastCommitID().Hash,
+	}).Result)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk := source.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: i})
+		res := target.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{Index: i, Chunk: chunk.Chunk})
+		require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, res.Result)
+	}
+	require.Equal(t, int64(1), target.LastBlockHeight())
+	require.Equal(t, "ufork", tokentypes.GetNativeToken().MinUnit)
+}
+
+func TestNativeTokenBeforeMintSchedules(t *testing.T) {
+	defer func() { require.NoError(t, SetNativeToken(DefaultNativeToken())) }()
+
+	db := dbm.NewMemDB()
+	newApp := func() *IrisApp {
+		return NewIrisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	}
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	stateBytes = bytes.ReplaceAll(stateBytes, []byte(`"uiris"`), []byte(`"ufork"`))
+	stateBytes = bytes.ReplaceAll(stateBytes, []byte(`"iris"`), []byte(`"fork"`))
+
+	app := newApp()
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	// restore the single minter and mint denom of a chain started before the mint schedules
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	store := ctx.KVStore(app.keys[minttypes.StoreKey])
+	for _, minter := range app.mintKeeper.GetMinters(ctx) {
+		store.Delete(minttypes.GetMinterKey(minter.Denom))
+	}
+	bz, err := proto.Marshal(&mintkeeper.LegacyMinter{InflationBase: "2000000000000000"})
+	require.NoError(t, err)
+	store.Set(minttypes.MinterKey, bz)
+
+	paramStore := prefix.NewStore(ctx.KVStore(app.keys[paramstypes.StoreKey]), []byte(minttypes.DefaultParamSpace+"/"))
+	paramStore.Delete(minttypes.KeySchedules)
+	paramStore.Set([]byte("Inflation"), []byte(`"0.040000000000000000"`))
+	paramStore.Set([]byte("MintDenom"), []byte(`"ufork"`))
+	app.Commit()
+
+	// the new binary starts before the mint schedules are migrated
+	require.NoError(t, SetNativeToken(DefaultNativeToken()))
+	app = newApp()
+	require.Equal(t, "uiris", tokentypes.GetNativeToken().MinUnit)
+
+	// the native token is loaded by the upgrade
+	for _, u := range upgrades {
+		if u.Name == "v1.1" {
+			app.upgradeHandler(u)(app.BaseApp.NewUncachedContext(false, tmproto.Header{}), upgradetypes.Plan{Name: u.Name})
+		}
+	}
+	require.Equal(t, "ufork", tokentypes.GetNativeToken().MinUnit)
+}
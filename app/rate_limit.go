@@ -0,0 +1,157 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+)
+
+// app.toml options of the tx rate limiter, they can be set in the [tx-rate-limit] section
+// of app.toml or by the flags of the start command
+const (
+	FlagTxRateLimitMaxTxs          = "tx-rate-limit.max-txs"
+	FlagTxRateLimitWindow          = "tx-rate-limit.window"
+	FlagTxRateLimitExemptSupers    = "tx-rate-limit.exempt-supers"
+	FlagTxRateLimitExemptAddresses = "tx-rate-limit.exempt-addresses"
+)
+
+// AddTxRateLimitFlags adds the tx rate limiter flags to the start command
+func AddTxRateLimitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Uint64(FlagTxRateLimitMaxTxs, 0, "Maximum number of txs signed by an account accepted into the mempool per window, 0 disables the limit")
+	startCmd.Flags().Int64(FlagTxRateLimitWindow, 1, "Number of blocks of a tx rate limit window")
+	startCmd.Flags().Bool(FlagTxRateLimitExemptSupers, false, "Exempt the guardian supers from the tx rate limit")
+	startCmd.Flags().StringSlice(FlagTxRateLimitExemptAddresses, nil, "Addresses exempted from the tx rate limit")
+}
+
+// TxRateLimiter counts the txs signed by each account in the current window of blocks.
+// It is in memory and only used in CheckTx, so it never affects the consensus state
+type TxRateLimiter struct {
+	mtx sync.Mutex
+
+	maxTxs          uint64
+	window          int64
+	exemptSupers    bool
+	exemptAddresses map[string]bool
+
+	currentWindow int64
+	counts        map[string]uint64
+}
+
+// NewTxRateLimiter creates a new TxRateLimiter instance
+func NewTxRateLimiter(maxTxs uint64, window int64, exemptSupers bool, exemptAddresses []string) *TxRateLimiter {
+	if window <= 0 {
+		window = 1
+	}
+
+	exempt := make(map[string]bool, len(exemptAddresses))
+	for _, addr := range exemptAddresses {
+		exempt[addr] = true
+	}
+
+	return &TxRateLimiter{
+		maxTxs:          maxTxs,
+		window:          window,
+		exemptSupers:    exemptSupers,
+		exemptAddresses: exempt,
+		counts:          make(map[string]uint64),
+	}
+}
+
+// NewTxRateLimiterFromAppOptions creates a TxRateLimiter from the app options
+func NewTxRateLimiterFromAppOptions(appOpts servertypes.AppOptions) *TxRateLimiter {
+	return NewTxRateLimiter(
+		cast.ToUint64(appOpts.Get(FlagTxRateLimitMaxTxs)),
+		cast.ToInt64(appOpts.Get(FlagTxRateLimitWindow)),
+		cast.ToBool(appOpts.Get(FlagTxRateLimitExemptSupers)),
+		cast.ToStringSlice(appOpts.Get(FlagTxRateLimitExemptAddresses)),
+	)
+}
+
+// Enabled returns true if the limiter limits any tx
+func (l *TxRateLimiter) Enabled() bool {
+	return l != nil && l.maxTxs > 0
+}
+
+// allow counts the tx signed by the given signers at the given height, false is returned
+// without counting if any signer has reached the limit of the window
+func (l *TxRateLimiter) allow(height int64, signers []string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if window := height / l.window; window != l.currentWindow {
+		l.currentWindow = window
+		l.counts = make(map[string]uint64)
+	}
+
+	for _, signer := range signers {
+		if l.counts[signer] >= l.maxTxs {
+			return false
+		}
+	}
+
+	for _, signer := range signers {
+		l.counts[signer]++
+	}
+
+	return true
+}
+
+// RateLimitDecorator rejects the txs whose signers have sent too many txs in the current window.
+// It must follow the signature verification and the other checks, so that the txs rejected by
+// them are never counted. Note this only applies to new txs when ctx.CheckTx = true
+type RateLimitDecorator struct {
+	limiter *TxRateLimiter
+	gk      guardiankeeper.Keeper
+}
+
+// NewRateLimitDecorator returns an instance of RateLimitDecorator
+func NewRateLimitDecorator(limiter *TxRateLimiter, gk guardiankeeper.Keeper) RateLimitDecorator {
+	return RateLimitDecorator{
+		limiter: limiter,
+		gk:      gk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate || !rld.limiter.Enabled() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	var signers []string
+	for _, signer := range sigTx.GetSigners() {
+		if rld.limiter.exemptAddresses[signer.String()] {
+			continue
+		}
+		if rld.limiter.exemptSupers {
+			if _, found := rld.gk.GetSuper(ctx, signer); found {
+				continue
+			}
+		}
+		signers = append(signers, signer.String())
+	}
+
+	if len(signers) > 0 && !rld.limiter.allow(ctx.BlockHeight(), signers) {
+		telemetry.IncrCounter(1, "tx", "rate_limit", "rejected")
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"tx rate limit exceeded: at most %d txs per signer every %d blocks", rld.limiter.maxTxs, rld.limiter.window,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+)
+
+type testSigTx struct {
+	testTx
+	signers []sdk.AccAddress
+}
+
+func (tx testSigTx) GetSigners() []sdk.AccAddress                    { return tx.signers }
+func (tx testSigTx) GetPubKeys() []cryptotypes.PubKey                { return nil }
+func (tx testSigTx) GetSignaturesV2() ([]signing.SignatureV2, error) { return nil, nil }
+
+func TestRateLimitDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	super := sdk.AccAddress([]byte("super_______________"))
+	exempted := sdk.AccAddress([]byte("exempted____________"))
+	app.guardianKeeper.AddSuper(ctx, guardiantypes.NewSuper("super", guardiantypes.Ordinary, super, super))
+
+	limiter := NewTxRateLimiter(2, 10, true, []string{exempted.String()})
+	decorator := NewRateLimitDecorator(limiter, app.guardianKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	checkTx := func(ctx sdk.Context, signers ...sdk.AccAddress) error {
+		_, err := decorator.AnteHandle(ctx, testSigTx{signers: signers}, false, next)
+		return err
+	}
+
+	checkCtx := ctx.WithIsCheckTx(true).WithBlockHeight(10)
+	require.NoError(t, checkTx(checkCtx, alice))
+	require.NoError(t, checkTx(checkCtx, alice, bob))
+	require.Error(t, checkTx(checkCtx, alice))
+	require.Error(t, checkTx(checkCtx, bob, alice))
+
+	// the rejected tx is not counted against bob
+	require.NoError(t, checkTx(checkCtx, bob))
+
+	// exempted accounts are not limited
+	for i := 0; i < 3; i++ {
+		require.NoError(t, checkTx(checkCtx, super))
+		require.NoError(t, checkTx(checkCtx, exempted))
+	}
+
+	// rechecks and deliveries are not limited
+	require.NoError(t, checkTx(checkCtx.WithIsReCheckTx(true), alice))
+	require.NoError(t, checkTx(ctx.WithBlockHeight(10), alice))
+
+	// the counts are reset in the next window
+	require.Error(t, checkTx(checkCtx.WithBlockHeight(19), alice))
+	require.NoError(t, checkTx(checkCtx.WithBlockHeight(20), alice))
+
+	// the limiter is disabled by default
+	decorator = NewRateLimitDecorator(NewTxRateLimiterFromAppOptions(EmptyAppOptions{}), app.guardianKeeper)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, checkTx(checkCtx, alice))
+	}
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
+	servicekeeper "github.com/irisnet/irismod/modules/service/keeper"
+	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
+
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	memokeeper "github.com/irisnet/irishub/modules/memo/keeper"
+	ratelimitkeeper "github.com/irisnet/irishub/modules/ratelimit/keeper"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+	irisservicekeeper "github.com/irisnet/irishub/modules/service/keeper"
+)
+
+// NewScheduledMsgValidator returns the validator of the scheduled msgs, which runs the decorators of the
+// ante handler checking the msgs of the txs
+func NewScheduledMsgValidator(
+	bk bankkeeper.Keeper,
+	tk tokenkeeper.Keeper,
+	ok oraclekeeper.Keeper,
+	sk servicekeeper.Keeper,
+	ssk irisservicekeeper.Keeper,
+	gk guardiankeeper.Keeper,
+	mk memokeeper.Keeper,
+	rk ratelimitkeeper.Keeper,
+) schedulertypes.MsgValidator {
+	handler := sdk.ChainAnteDecorators(NewValidateMsgsDecorator(
+		NewValidateMemoRequiredDecorator(mk),
+		NewValidateTokenDecorator(tk),
+		NewValidateTransferQuotaDecorator(rk),
+		tokenkeeper.NewValidateTokenFeeDecorator(tk, bk),
+		oraclekeeper.NewValidateOracleAuthDecorator(ok, gk),
+		NewValidateServiceDecorator(sk, ssk, gk),
+	))
+
+	// the scheduled msgs have no memo
+	return func(ctx sdk.Context, msgs []sdk.Msg) error {
+		_, err := handler(ctx, msgsTx{msgs: msgs}, false)
+		return err
+	}
+}
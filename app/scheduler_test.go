@@ -0,0 +1,50 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+func TestScheduledMsgValidator(t *testing.T) {
+	app := NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Height: 1})
+
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	exchange := sdk.AccAddress([]byte("exchange____________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+	app.memoKeeper.SetMemoRequired(ctx, exchange, true)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uiris", 1))
+	require.NoError(t, app.bankKeeper.SetBalances(ctx, creator, coins.Add(coins...).Add(fee...).Add(fee...)))
+
+	// the scheduled msgs have no memo, so the send to the exchange is rejected on execution
+	for _, to := range []sdk.AccAddress{exchange, other} {
+		msgs, err := msgrouter.PackMsgs([]sdk.Msg{banktypes.NewMsgSend(creator, to, coins)})
+		require.NoError(t, err)
+		_, err = app.schedulerKeeper.CreateSchedule(ctx, creator, msgs, 2, nil, 200000, fee)
+		require.NoError(t, err)
+	}
+
+	app.schedulerKeeper.ExecuteDueSchedules(ctx.WithBlockHeight(2))
+	require.True(t, app.bankKeeper.GetAllBalances(ctx, exchange).IsZero())
+	require.Equal(t, coins, app.bankKeeper.GetAllBalances(ctx, other))
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/sqlsink"
+)
+
+// app.toml options of the SQL event sink, they can be set in the [sql-sink] section
+// of app.toml or by the flags of the start command
+const (
+	FlagSQLSinkEnable          = "sql-sink.enable"
+	FlagSQLSinkPath            = "sql-sink.path"
+	FlagSQLSinkStopNodeOnError = "sql-sink.stop-node-on-error"
+)
+
+// AddSQLSinkFlags adds the SQL event sink flags to the start command
+func AddSQLSinkFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagSQLSinkEnable, false, "Write the blocks, txs, messages and events of every committed block into an SQLite database")
+	startCmd.Flags().String(FlagSQLSinkPath, "", "Path of the SQLite database, defaults to data/events.db in the home directory")
+	startCmd.Flags().Bool(FlagSQLSinkStopNodeOnError, false, "Stop the node if a block fails to be written into the SQLite database")
+}
+
+// DefaultSQLSinkPath returns the default path of the SQL event sink database
+func DefaultSQLSinkPath(homePath string) string {
+	return filepath.Join(homePath, "data", "events.db")
+}
+
+// newSQLSinkFromAppOptions creates a Sink from the app options and returns whether the node
+// stops on sink errors, nil is returned if the sink is disabled
+func newSQLSinkFromAppOptions(
+	homePath string, appOpts servertypes.AppOptions, txDecoder sdk.TxDecoder, cdc codec.JSONMarshaler,
+) (*sqlsink.Sink, bool, error) {
+	if !cast.ToBool(appOpts.Get(FlagSQLSinkEnable)) {
+		return nil, false, nil
+	}
+
+	path := cast.ToString(appOpts.Get(FlagSQLSinkPath))
+	if path == "" {
+		path = DefaultSQLSinkPath(homePath)
+	}
+
+	sink, err := sqlsink.NewSink(path, txDecoder, cdc)
+	return sink, cast.ToBool(appOpts.Get(FlagSQLSinkStopNodeOnError)), err
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+func TestSQLSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.db")
+	app := NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), mapAppOptions{
+		FlagSQLSinkEnable: true,
+		FlagSQLSinkPath:   path,
+	})
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	genesisTime := time.Now().UTC()
+	app.InitChain(abci.RequestInitChain{Time: genesisTime, Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	var commits []abci.ResponseCommit
+	for height := int64(2); height <= 3; height++ {
+		header := tmproto.Header{ChainID: "irishub", Height: height, Time: genesisTime.Add(time.Duration(height) * time.Minute)}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: []byte{byte(height)}})
+		// the undecodable txs are written without messages
+		app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("invalid tx")})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		commits = append(commits, app.Commit())
+	}
+
+	db, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.Query("SELECT height, hash, chain_id, num_txs, app_hash FROM blocks ORDER BY height")
+	require.NoError(t, err)
+	var heights []int64
+	for rows.Next() {
+		var (
+			height        int64
+			hash, chainID string
+			numTxs        int
+			appHash       string
+		)
+		require.NoError(t, rows.Scan(&height, &hash, &chainID, &numTxs, &appHash))
+		require.Equal(t, "irishub", chainID)
+		require.Equal(t, 1, numTxs)
+		require.Equal(t, fmt.Sprintf("%X", []byte{byte(height)}), hash)
+		require.Equal(t, fmt.Sprintf("%X", commits[height-2].Data), appHash)
+		heights = append(heights, height)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []int64{2, 3}, heights)
+
+	var code uint32
+	require.NoError(t, db.QueryRow("SELECT code FROM txs WHERE height = 2 AND tx_index = 0").Scan(&code))
+	require.NotZero(t, code)
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM messages").Scan(&count))
+	require.Zero(t, count)
+
+	// the mint provisions are written into the typed table and the generic tables
+	var denom, amount string
+	require.NoError(t, db.QueryRow("SELECT denom, amount FROM mint_provisions WHERE height = 3").Scan(&denom, &amount))
+	require.Equal(t, "uiris", denom)
+	require.NoError(t, db.QueryRow(
+		`SELECT COUNT(*) FROM events e JOIN attributes a ON a.event_id = e.id
+		WHERE e.height = 3 AND e.source = 'begin_block' AND e.type = 'mint' AND a.key = 'mint_coin' AND a.value = ?`,
+		amount,
+	).Scan(&count))
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// KVDiff defines a key whose values differ in a store of two apps,
+// a nil value means the key does not exist in the app
+type KVDiff struct {
+	StoreKey string
+	Key      []byte
+	ValueA   []byte
+	ValueB   []byte
+	// Decoded is the values decoded by the store decoder of the module,
+	// empty if the store has no decoder or the decoder fails
+	Decoded string
+}
+
+// String implements fmt.Stringer
+func (d KVDiff) String() string {
+	if d.Decoded != "" {
+		return fmt.Sprintf("%s %X\n%s", d.StoreKey, d.Key, d.Decoded)
+	}
+	return fmt.Sprintf("%s %X\n%s\n%s", d.StoreKey, d.Key, formatValue(d.ValueA), formatValue(d.ValueB))
+}
+
+func formatValue(value []byte) string {
+	if value == nil {
+		return "<nil>"
+	}
+	return hex.EncodeToString(value)
+}
+
+// StoreKeys returns the sorted names of the persistent store keys of the app
+func (app *IrisApp) StoreKeys() []string {
+	names := make([]string, 0, len(app.keys))
+	for name := range app.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DiffStores compares the stores of two apps at the given height and returns the differing keys.
+// All the persistent stores are compared if no store key is given
+func DiffStores(appA, appB *IrisApp, height int64, storeKeys ...string) ([]KVDiff, error) {
+	if len(storeKeys) == 0 {
+		storeKeys = appA.StoreKeys()
+	}
+	for _, name := range storeKeys {
+		if appA.keys[name] == nil {
+			return nil, fmt.Errorf("unknown store key: %s", name)
+		}
+	}
+
+	msA, err := appA.multiStoreAt(height)
+	if err != nil {
+		return nil, err
+	}
+	msB, err := appB.multiStoreAt(height)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []KVDiff
+	for _, name := range storeKeys {
+		// the store keys are compared by pointer, so each app has its own
+		storeA, storeB := msA.GetKVStore(appA.keys[name]), msB.GetKVStore(appB.keys[name])
+		diffs = append(diffs, appA.diffKVStores(name, storeA, storeB)...)
+	}
+	return diffs, nil
+}
+
+// multiStoreAt returns a cached view of the multistore at the given height
+func (app *IrisApp) multiStoreAt(height int64) (sdk.CacheMultiStore, error) {
+	if height > app.LastBlockHeight() {
+		return nil, fmt.Errorf("height %d is greater than the latest height %d", height, app.LastBlockHeight())
+	}
+
+	ms, err := app.NewUncachedContext(true, tmproto.Header{}).MultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state at height %d: %w", height, err)
+	}
+	return ms, nil
+}
+
+// diffKVStores walks both stores in the key order and collects the keys whose values differ
+func (app *IrisApp) diffKVStores(name string, a, b sdk.KVStore) (diffs []KVDiff) {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var diff KVDiff
+
+		switch {
+		case !iterB.Valid() || (iterA.Valid() && bytes.Compare(iterA.Key(), iterB.Key()) < 0):
+			diff = KVDiff{StoreKey: name, Key: iterA.Key(), ValueA: iterA.Value()}
+			iterA.Next()
+
+		case !iterA.Valid() || bytes.Compare(iterA.Key(), iterB.Key()) > 0:
+			diff = KVDiff{StoreKey: name, Key: iterB.Key(), ValueB: iterB.Value()}
+			iterB.Next()
+
+		default:
+			valueA, valueB := iterA.Value(), iterB.Value()
+			diff = KVDiff{StoreKey: name, Key: iterA.Key(), ValueA: valueA, ValueB: valueB}
+			iterA.Next()
+			iterB.Next()
+			if bytes.Equal(valueA, valueB) {
+				continue
+			}
+		}
+
+		diff.Decoded = app.decodeKVPair(name, diff.Key, diff.ValueA, diff.ValueB)
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// decodeKVPair decodes the values with the store decoder of the simulation manager,
+// an empty string is returned if there is no decoder or the decoder panics
+func (app *IrisApp) decodeKVPair(name string, key, valueA, valueB []byte) (decoded string) {
+	decoder, ok := app.sm.StoreDecoders[name]
+	if !ok {
+		return ""
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = ""
+		}
+	}()
+	return decoder(kv.Pair{Key: key, Value: valueA}, kv.Pair{Key: key, Value: valueB})
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestDiffStores(t *testing.T) {
+	newApp := func() *IrisApp {
+		return NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	}
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	appA, appB := newApp(), newApp()
+	for _, app := range []*IrisApp{appA, appB} {
+		app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+		app.Commit()
+	}
+
+	// the apps diverge at height 2
+	header := tmproto.Header{Height: 2}
+	for _, app := range []*IrisApp{appA, appB} {
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	}
+	ctxA := appA.BaseApp.NewContext(false, header)
+	appA.memoKeeper.SetMemoRequired(ctxA, addr, true)
+	minter, found := appA.mintKeeper.GetMinter(ctxA, sdk.DefaultBondDenom)
+	if !found {
+		minter = minttypes.Minter{Denom: sdk.DefaultBondDenom}
+	}
+	minter.LastUpdate = minter.LastUpdate.Add(1)
+	appA.mintKeeper.SetMinter(ctxA, minter)
+	for _, app := range []*IrisApp{appA, appB} {
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	diffs, err := DiffStores(appA, appB, 1)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	diffs, err = DiffStores(appA, appB, 2, memotypes.StoreKey, minttypes.StoreKey)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+
+	// the memo store has no decoder
+	require.Equal(t, memotypes.StoreKey, diffs[0].StoreKey)
+	require.Equal(t, memotypes.GetMemoRequiredKey(addr), diffs[0].Key)
+	require.Equal(t, []byte{0x01}, diffs[0].ValueA)
+	require.Nil(t, diffs[0].ValueB)
+	require.Empty(t, diffs[0].Decoded)
+
+	// the mint store is decoded
+	require.Equal(t, minttypes.StoreKey, diffs[1].StoreKey)
+	require.NotEmpty(t, diffs[1].Decoded)
+	require.Contains(t, diffs[1].String(), diffs[1].Decoded)
+
+	_, err = DiffStores(appA, appB, 2, "unknown")
+	require.Error(t, err)
+	_, err = DiffStores(appA, appB, 3)
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/irisnet/irishub/streaming"
+)
+
+// app.toml options of the state streaming, they can be set in the [streaming] section
+// of app.toml or by the flags of the start command
+const (
+	FlagStreamingEnable          = "streaming.enable"
+	FlagStreamingDir             = "streaming.dir"
+	FlagStreamingKeepRecent      = "streaming.keep-recent"
+	FlagStreamingStopNodeOnError = "streaming.stop-node-on-error"
+)
+
+// AddStreamingFlags adds the state streaming flags to the start command
+func AddStreamingFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagStreamingEnable, false, "Stream the committed KV writes and the ABCI records of every block to local files")
+	startCmd.Flags().String(FlagStreamingDir, "", "Directory of the streamed files, defaults to data/streaming in the home directory")
+	startCmd.Flags().Int64(FlagStreamingKeepRecent, 0, "Number of recent blocks whose streamed files are kept, 0 keeps all the files")
+	startCmd.Flags().Bool(FlagStreamingStopNodeOnError, true, "Stop the node if the streamed files of a block fail to be written")
+}
+
+// newStreamerFromAppOptions creates a FileStreamer from the app options and returns whether the node
+// stops on streaming errors, nil is returned if the streaming is disabled
+func newStreamerFromAppOptions(homePath string, appOpts servertypes.AppOptions) (*streaming.FileStreamer, bool, error) {
+	if !cast.ToBool(appOpts.Get(FlagStreamingEnable)) {
+		return nil, false, nil
+	}
+
+	dir := cast.ToString(appOpts.Get(FlagStreamingDir))
+	if dir == "" {
+		dir = filepath.Join(homePath, "data", "streaming")
+	}
+
+	streamer, err := streaming.NewFileStreamer(dir, cast.ToInt64(appOpts.Get(FlagStreamingKeepRecent)))
+	return streamer, cast.ToBool(appOpts.Get(FlagStreamingStopNodeOnError)), err
+}
+
+// setStreamingCMS replaces the commit multistore of the BaseApp with one passing the committed
+// writes to the streamer. It must be the first BaseApp option so that the other options apply
+// to the replaced store
+func setStreamingCMS(db dbm.DB, streamer *streaming.FileStreamer) func(*baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetCMS(streaming.NewCommitMultiStore(db, streamer))
+	}
+}
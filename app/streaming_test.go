@@ -0,0 +1,112 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
+	"github.com/irisnet/irishub/streaming"
+)
+
+// mapAppOptions is a stub implementing AppOptions
+type mapAppOptions map[string]interface{}
+
+// Get implements AppOptions
+func (ao mapAppOptions) Get(o string) interface{} {
+	return ao[o]
+}
+
+func TestStreaming(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "streaming")
+	db := dbm.NewMemDB()
+	appOpts := mapAppOptions{
+		FlagStreamingEnable:     true,
+		FlagStreamingDir:        dir,
+		FlagStreamingKeepRecent: 2,
+	}
+	newApp := func(db dbm.DB, appOpts mapAppOptions) *IrisApp {
+		return NewIrisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), appOpts, interBlockCacheOpt())
+	}
+
+	app := newApp(db, appOpts)
+	// the state must be the same as the one of an app without streaming
+	plainApp := newApp(dbm.NewMemDB(), mapAppOptions{})
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	var commits []abci.ResponseCommit
+	for _, a := range []*IrisApp{app, plainApp} {
+		a.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+		// the memo requirement is set at height 2 and removed at height 3
+		for height := int64(1); height <= 3; height++ {
+			header := tmproto.Header{Height: height}
+			a.BeginBlock(abci.RequestBeginBlock{Header: header})
+			if height > 1 {
+				a.memoKeeper.SetMemoRequired(a.BaseApp.NewContext(false, header), addr, height == 2)
+			}
+			a.EndBlock(abci.RequestEndBlock{Height: height})
+			res := a.Commit()
+			if a == app {
+				commits = append(commits, res)
+			} else {
+				require.Equal(t, commits[height-1], res)
+			}
+		}
+	}
+
+	// the files of height 1 are removed
+	_, err = os.Stat(filepath.Join(dir, streaming.MetaFileName(1)))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, streaming.DataFileName(1)))
+	require.True(t, os.IsNotExist(err))
+
+	memoKey := memotypes.GetMemoRequiredKey(addr)
+	for height := int64(2); height <= 3; height++ {
+		meta, pairs, err := streaming.ReadBlock(dir, height)
+		require.NoError(t, err)
+		require.Equal(t, height, meta.Height)
+		require.Equal(t, height, meta.RequestBeginBlock.Header.Height)
+		require.Equal(t, height, meta.RequestEndBlock.Height)
+		require.Equal(t, commits[height-1], *meta.ResponseCommit)
+
+		var memoPairs []int
+		for i, pair := range pairs {
+			if i > 0 {
+				require.LessOrEqual(t, pairs[i-1].StoreKey, pair.StoreKey)
+			}
+			if pair.StoreKey == memotypes.StoreKey && string(pair.Key) == string(memoKey) {
+				memoPairs = append(memoPairs, i)
+			}
+		}
+		require.Len(t, memoPairs, 1)
+
+		pair := pairs[memoPairs[0]]
+		if height == 2 {
+			require.False(t, pair.Delete)
+			require.Equal(t, []byte{0x01}, pair.Value)
+		} else {
+			require.True(t, pair.Delete)
+			require.Empty(t, pair.Value)
+		}
+	}
+
+	// the committed state is read back by a restarted app
+	app = newApp(db, appOpts)
+	require.False(t, app.memoKeeper.IsMemoRequired(app.BaseApp.NewContext(true, tmproto.Header{}), addr))
+	require.Equal(t, commits[2].Data, app.LastCommitID().Hash)
+}
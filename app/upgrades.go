@@ -0,0 +1,150 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	authztypes "github.com/irisnet/irishub/modules/authz/types"
+	feegranttypes "github.com/irisnet/irishub/modules/feegrant/types"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	nfttransfertypes "github.com/irisnet/irishub/modules/nfttransfer/types"
+	ratelimittypes "github.com/irisnet/irishub/modules/ratelimit/types"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+	irisservicetypes "github.com/irisnet/irishub/modules/service/types"
+)
+
+// Upgrade defines an upgrade plan which is applied when the software upgrade proposal
+// of the same name is executed. The store keys are added, renamed or deleted when the
+// new binary starts at the upgrade height, then the migrations are run in order in the
+// begin blocker of the upgrade height
+type Upgrade struct {
+	// Name is the name of the software upgrade proposal plan
+	Name string
+	// StoreUpgrades are the store keys added, renamed or deleted by the upgrade
+	StoreUpgrades storetypes.StoreUpgrades
+	// Migrations are the state migrations of the modules run by the upgrade
+	Migrations []Migration
+}
+
+// Migration defines a state migration of a module
+type Migration struct {
+	// Module is the name of the migrated module
+	Module string
+	// Migrate migrates the state of the module
+	Migrate func(ctx sdk.Context, app *IrisApp) error
+}
+
+// upgrades is the registry of the upgrade plans, new plans are appended to it
+var upgrades = []Upgrade{
+	{
+		Name: "v1.1",
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				feegranttypes.StoreKey, memotypes.StoreKey, ratelimittypes.StoreKey, nfttransfertypes.StoreKey,
+				schedulertypes.StoreKey, authztypes.StoreKey,
+				// the retired service definitions are stored apart from the service store
+				irisservicetypes.StoreKey,
+			},
+		},
+		Migrations: []Migration{
+			{
+				// the single minter and mint denom are replaced by the mint schedules
+				Module: minttypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					return app.mintKeeper.MigrateSchedules(ctx)
+				},
+			},
+			{
+				// the native token is minted by the migrated mint schedules
+				Module: tokentypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					return app.loadNativeToken(ctx)
+				},
+			},
+			{
+				// the params of the guardian module are added
+				Module: guardiantypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					app.guardianKeeper.MigrateParams(ctx)
+					return nil
+				},
+			},
+			// the modules added by the upgrade are initialized with their default genesis states,
+			// the nfttransfer port is bound by its genesis
+			initGenesis(feegranttypes.ModuleName),
+			initGenesis(memotypes.ModuleName),
+			initGenesis(ratelimittypes.ModuleName),
+			initGenesis(nfttransfertypes.ModuleName),
+			initGenesis(schedulertypes.ModuleName),
+			initGenesis(authztypes.ModuleName),
+		},
+	},
+}
+
+// initGenesis returns the migration initializing the state of a module added by an upgrade
+// with the default genesis state of the module
+func initGenesis(moduleName string) Migration {
+	return Migration{
+		Module: moduleName,
+		Migrate: func(ctx sdk.Context, app *IrisApp) error {
+			module, ok := app.mm.Modules[moduleName]
+			if !ok {
+				return fmt.Errorf("module %s not found", moduleName)
+			}
+
+			module.InitGenesis(ctx, app.appCodec, module.DefaultGenesis(app.appCodec))
+			return nil
+		},
+	}
+}
+
+// registerUpgrades sets the upgrade handlers of the upgrade plans and the store loader
+// of the plan being upgraded to. It must be called before loading the latest version
+func (app *IrisApp) registerUpgrades(upgrades []Upgrade) {
+	registered := make(map[string]bool)
+	for _, u := range upgrades {
+		if registered[u.Name] {
+			panic(fmt.Sprintf("upgrade plan %s registered twice", u.Name))
+		}
+		registered[u.Name] = true
+
+		app.upgradeKeeper.SetUpgradeHandler(u.Name, app.upgradeHandler(u))
+	}
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	// the upgrade info is written by the old binary when it halts at the upgrade height
+	if upgradeInfo.Name == "" || app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, u := range upgrades {
+		if u.Name == upgradeInfo.Name {
+			storeUpgrades := u.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+			return
+		}
+	}
+}
+
+// upgradeHandler returns the handler running the migrations of the upgrade plan
+func (app *IrisApp) upgradeHandler(u Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan) {
+		for _, m := range u.Migrations {
+			if err := m.Migrate(ctx, app); err != nil {
+				panic(fmt.Sprintf("failed to migrate %s in upgrade %s: %s", m.Module, u.Name, err))
+			}
+			ctx.Logger().Info(fmt.Sprintf("migrated %s in upgrade %s", m.Module, u.Name))
+		}
+	}
+}
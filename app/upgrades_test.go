@@ -0,0 +1,185 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	ibchost "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
+	randomtypes "github.com/irisnet/irismod/modules/random/types"
+	recordtypes "github.com/irisnet/irismod/modules/record/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	nfttransfertypes "github.com/irisnet/irishub/modules/nfttransfer/types"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+)
+
+func TestUpgrade(t *testing.T) {
+	home := t.TempDir()
+	db := dbm.NewMemDB()
+	newApp := func() *IrisApp {
+		return NewIrisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	}
+
+	const upgradeHeight = 3
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	// the old binary schedules the upgrade plan
+	app := newApp()
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	header := tmproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+	require.NoError(t, app.upgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: "test-upgrade", Height: upgradeHeight}))
+	app.memoKeeper.SetMemoRequired(ctx, addr, true)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the old binary halts at the upgrade height
+	header = tmproto.Header{Height: upgradeHeight}
+	require.Panics(t, func() { app.BeginBlock(abci.RequestBeginBlock{Header: header}) })
+
+	// the new binary deletes the memo store and runs the migrations
+	var migrated []string
+	defer func(registered []Upgrade) { upgrades = registered }(upgrades)
+	upgrades = []Upgrade{{
+		Name:          "test-upgrade",
+		StoreUpgrades: storetypes.StoreUpgrades{Deleted: []string{memotypes.StoreKey}},
+		Migrations: []Migration{
+			{
+				Module: memotypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					require.False(t, app.memoKeeper.IsMemoRequired(ctx, addr))
+					migrated = append(migrated, memotypes.ModuleName)
+					return nil
+				},
+			},
+			{
+				Module: upgradetypes.ModuleName,
+				Migrate: func(ctx sdk.Context, app *IrisApp) error {
+					migrated = append(migrated, upgradetypes.ModuleName)
+					return nil
+				},
+			},
+		},
+	}}
+
+	app = newApp()
+	require.Equal(t, int64(upgradeHeight-1), app.LastBlockHeight())
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = app.BaseApp.NewContext(false, header)
+	require.Equal(t, []string{memotypes.ModuleName, upgradetypes.ModuleName}, migrated)
+	require.Equal(t, int64(upgradeHeight), app.upgradeKeeper.GetDoneHeight(ctx, "test-upgrade"))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+}
+
+func TestUpgradeV11(t *testing.T) {
+	app := NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	var upgrade Upgrade
+	for _, u := range upgrades {
+		if u.Name == "v1.1" {
+			upgrade = u
+		}
+	}
+
+	// the stores of v1.0 are kept, the others are added
+	v10StoreKeys := map[string]bool{}
+	for _, key := range []string{
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
+		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
+		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
+		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
+		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
+		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
+	} {
+		v10StoreKeys[key] = true
+	}
+	var added []string
+	for key := range app.keys {
+		if !v10StoreKeys[key] {
+			added = append(added, key)
+		}
+	}
+	require.ElementsMatch(t, added, upgrade.StoreUpgrades.Added)
+
+	// restore the state of v1.0 in the added stores and the guardian params
+	for _, key := range upgrade.StoreUpgrades.Added {
+		store := ctx.KVStore(app.keys[key])
+		iterator := store.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	paramStore := prefix.NewStore(ctx.KVStore(app.keys[paramstypes.StoreKey]), []byte(guardiantypes.ModuleName+"/"))
+	for _, key := range [][]byte{
+		guardiantypes.KeyMaxRepeatedTotal, guardiantypes.KeyMinRepeatedFrequency, guardiantypes.KeyMaxRepeatedFeeCap,
+		guardiantypes.KeyFeeDenoms, guardiantypes.KeyMaxFeeSlippage, guardiantypes.KeyMinFeePoolLiquidity,
+		guardiantypes.KeyMsgMinGasPrices,
+	} {
+		paramStore.Delete(key)
+	}
+	require.Panics(t, func() { app.guardianKeeper.GetParamSet(ctx) })
+
+	app.upgradeHandler(upgrade)(ctx, upgradetypes.Plan{Name: upgrade.Name})
+
+	require.Equal(t, guardiantypes.DefaultParams(), app.guardianKeeper.GetParamSet(ctx))
+	require.Equal(t, schedulertypes.DefaultParams(), app.schedulerKeeper.GetParams(ctx))
+	require.Equal(t, uint64(1), app.schedulerKeeper.GetNextScheduleID(ctx))
+	require.Equal(t, nfttransfertypes.PortID, app.nftTransferKeeper.GetPort(ctx))
+	require.True(t, app.nftTransferKeeper.IsBound(ctx, nfttransfertypes.PortID))
+}
+
+func TestRegisterUpgradesTwice(t *testing.T) {
+	defer func(registered []Upgrade) { upgrades = registered }(upgrades)
+	upgrades = []Upgrade{{Name: "test-upgrade"}, {Name: "test-upgrade"}}
+
+	require.Panics(t, func() {
+		NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{})
+	})
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/irisnet/irishub/address"
+)
+
+const (
+	flagFrom   = "from"
+	flagTo     = "to"
+	flagOutput = "output"
+)
+
+// AddrConvertCmd returns the command converting addresses and public keys between Bech32 prefixes
+func AddrConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert [address-or-file]",
+		Short: "Convert Bech32 addresses and public keys between the prefixes of networks",
+		Long: fmt.Sprintf(`Convert a Bech32 address or public key, or all the ones in a CSV or JSON file, to the same
+form with the target prefixes. The account, validator and consensus addresses and public keys are supported.
+
+The prefixes are given as %[1]s (iaa, iva, ica...), %[2]s (the legacy faa, fva, fca...), or an account address
+prefix of which the other prefixes follow the Cosmos SDK convention (cosmos, cosmosvaloper, cosmosvalcons...).
+The source prefixes default to both %[1]s and %[2]s.
+
+The files are rewritten textually, so that the other content and the layouts are kept. The result is written
+to the output file if given, or printed otherwise.`, address.NetworkMainnet, address.NetworkLegacyTestnet),
+		Example: `$ iris debug addr convert faa1rulhmls7g9cjh239vnkjnw870t5urrutgxg5m9 --to mainnet
+$ iris debug addr convert accounts.csv --from mainnet --to cosmos --output accounts-cosmos.csv`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			toStr, _ := cmd.Flags().GetString(flagTo)
+			target, err := address.GetBech32Prefixes(toStr)
+			if err != nil {
+				return err
+			}
+
+			sources := []address.Bech32Prefixes{address.MainnetPrefixes, address.LegacyTestnetPrefixes}
+			if fromStr, _ := cmd.Flags().GetString(flagFrom); fromStr != "" {
+				source, err := address.GetBech32Prefixes(fromStr)
+				if err != nil {
+					return err
+				}
+				sources = []address.Bech32Prefixes{source}
+			}
+
+			if _, err := os.Stat(args[0]); err != nil {
+				converted, err := address.ConvertBech32(args[0], target, sources...)
+				if err != nil {
+					return err
+				}
+				cmd.Println(converted)
+				return nil
+			}
+
+			text, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			converted, count := address.ConvertBech32Text(text, target, sources...)
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				_, err := cmd.OutOrStdout().Write(converted)
+				return err
+			}
+			if err := ioutil.WriteFile(output, converted, 0644); err != nil {
+				return err
+			}
+			cmd.Printf("%d bech32 strings converted to %s\n", count, output)
+			return nil
+		},
+	}
+
+	cmd.SetOut(cmd.OutOrStdout())
+	cmd.Flags().String(flagFrom, "", "The source prefixes, defaults to both the mainnet and the legacy testnet ones")
+	cmd.Flags().String(flagTo, address.NetworkMainnet, "The target prefixes")
+	cmd.Flags().String(flagOutput, "", "The file to write the converted content to, printed if empty")
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// tokenSyncPageLimit is the number of tokens queried per page when syncing the token cache
+const tokenSyncPageLimit = 100
+
+// ConfigCmd returns the command managing the local client configuration
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "config",
+		Short:                      "Manage the local client configuration",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(tokensConfigCmd())
+	return cmd
+}
+
+func tokensConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage the local token metadata cache",
+		Long: `Manage the local token metadata cache in the config directory of the client home. The cached
+scales convert the coins between the min units and the main units offline and in --generate-only mode.`,
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(SyncTokensCmd())
+	return cmd
+}
+
+// SyncTokensCmd returns the command caching the metadata of all the tokens of the node
+func SyncTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sync",
+		Short:   "Cache the metadata of all the tokens of the node",
+		Example: "$ iris config tokens sync --node=tcp://localhost:26657",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := tokentypes.NewQueryClient(clientCtx)
+			cache := loadTokenCache(clientCtx.HomeDir)
+			now := time.Now()
+
+			count := 0
+			pageReq := &query.PageRequest{Limit: tokenSyncPageLimit}
+			for {
+				res, err := queryClient.Tokens(context.Background(), &tokentypes.QueryTokensRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				for _, any := range res.Tokens {
+					var token tokentypes.TokenI
+					if err := clientCtx.InterfaceRegistry.UnpackAny(any, &token); err != nil {
+						return err
+					}
+					cache.set(token, now)
+					count++
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: tokenSyncPageLimit}
+			}
+
+			if err := cache.save(); err != nil {
+				return err
+			}
+			cmd.Printf("%d tokens cached in %s\n", count, cache.path)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
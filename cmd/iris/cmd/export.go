@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/app"
+)
+
+const (
+	flagTraceStore     = "trace-store"
+	flagModules        = "modules"
+	flagExcludeModules = "exclude-modules"
+	flagOutputDocument = "output-document"
+	flagGzip           = "gzip"
+)
+
+// ExportCmd dumps app state to JSON. Unlike the cosmos-sdk export command, the app state
+// is streamed to the output one module at a time and can be limited to a set of modules.
+func ExportCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export state to JSON",
+		Long: `Export state to JSON.
+
+The state of the modules is streamed to the output document (stdout by default),
+it can be limited with --modules and --exclude-modules and compressed with --gzip.`,
+		Example: "$ iris export --modules=bank,staking --output-document=state.json.gz --gzip",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore)
+			traceWriter, err := openTraceWriter(traceWriterFile)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+			modules, _ := cmd.Flags().GetStringSlice(flagModules)
+			excludedModules, _ := cmd.Flags().GetStringSlice(flagExcludeModules)
+			outputDocument, _ := cmd.Flags().GetString(flagOutputDocument)
+			useGzip, _ := cmd.Flags().GetBool(flagGzip)
+
+			out := cmd.OutOrStdout()
+			if outputDocument != "" {
+				file, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			buf := bufio.NewWriter(out)
+			var w io.Writer = buf
+			var gz *gzip.Writer
+			if useGzip {
+				gz = gzip.NewWriter(buf)
+				w = gz
+			}
+
+			encCfg := app.MakeEncodingConfig()
+			encCfg.Marshaler = codec.NewProtoCodec(encCfg.InterfaceRegistry)
+
+			// the app is always loaded at the latest height, historical state is read from a cached view
+			irisApp := app.NewIrisApp(
+				serverCtx.Logger, db, traceWriter, true, map[int64]bool{}, "", uint(1), encCfg, serverCtx.Viper,
+			)
+
+			if _, err := io.WriteString(w, `{"app_state":`); err != nil {
+				return err
+			}
+
+			exported, err := irisApp.ExportAppState(w, app.ExportOptions{
+				Height:           height,
+				ForZeroHeight:    forZeroHeight,
+				JailAllowedAddrs: jailAllowedAddrs,
+				Modules:          modules,
+				ExcludedModules:  excludedModules,
+			})
+			if err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
+
+			doc.AppState = nil
+			doc.Validators = exported.Validators
+			doc.InitialHeight = exported.Height
+			doc.ConsensusParams = &tmproto.ConsensusParams{
+				Block: tmproto.BlockParams{
+					MaxBytes:   exported.ConsensusParams.Block.MaxBytes,
+					MaxGas:     exported.ConsensusParams.Block.MaxGas,
+					TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
+				},
+				Evidence: tmproto.EvidenceParams{
+					MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+					MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+					MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+				},
+				Validator: tmproto.ValidatorParams{
+					PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+				},
+			}
+
+			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc, the app state
+			// is omitted here and the remaining fields are appended to the streamed one
+			encoded, err := tmjson.Marshal(doc)
+			if err != nil {
+				return err
+			}
+
+			encoded = bytes.TrimPrefix(sdk.MustSortJSON(encoded), []byte("{"))
+			if _, err := w.Write(append([]byte(","), encoded...)); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+
+			if gz != nil {
+				if err := gz.Close(); err != nil {
+					return err
+				}
+			}
+			return buf.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(server.FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(server.FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().StringSlice(flagModules, []string{}, "Comma-separated list of modules to export, all the modules are exported if empty")
+	cmd.Flags().StringSlice(flagExcludeModules, []string{}, "Comma-separated list of modules not to export")
+	cmd.Flags().String(flagOutputDocument, "", "The file to write the exported state to, stdout if empty")
+	cmd.Flags().Bool(flagGzip, false, "Compress the exported state with gzip")
+
+	return cmd
+}
+
+// replaceCommand replaces the child command of the root command with the same name
+func replaceCommand(rootCmd *cobra.Command, cmd *cobra.Command) {
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == cmd.Name() {
+			rootCmd.RemoveCommand(c)
+		}
+	}
+	rootCmd.AddCommand(cmd)
+}
+
+func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
+	if traceWriterFile == "" {
+		return
+	}
+	return os.OpenFile(
+		traceWriterFile,
+		os.O_WRONLY|os.O_APPEND|os.O_CREATE,
+		0666,
+	)
+}
@@ -19,12 +19,16 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	vestingcli "github.com/irisnet/irishub/modules/vesting/client/cli"
+	vestingtypes "github.com/irisnet/irishub/modules/vesting/types"
 )
 
 const (
-	flagVestingStart = "vesting-start-time"
-	flagVestingEnd   = "vesting-end-time"
-	flagVestingAmt   = "vesting-amount"
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingAmt     = "vesting-amount"
+	flagVestingPeriods = "vesting-periods"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -35,7 +39,8 @@ func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 		Long: `Add a genesis account to genesis.json. The provided account must specify
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
-contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+contain valid denominations. Accounts may optionally be supplied with vesting parameters,
+or with a periods JSON file for periodic vesting accounts.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -75,6 +80,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			vestingStart, _ := cmd.Flags().GetInt64(flagVestingStart)
 			vestingEnd, _ := cmd.Flags().GetInt64(flagVestingEnd)
 			vestingAmtStr, _ := cmd.Flags().GetString(flagVestingAmt)
+			vestingPeriodsFile, _ := cmd.Flags().GetString(flagVestingPeriods)
 
 			vestingAmt, err := sdk.ParseCoinsNormalized(vestingAmtStr)
 			if err != nil {
@@ -87,7 +93,25 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
 			baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
 
-			if !vestingAmt.IsZero() {
+			if vestingPeriodsFile != "" {
+				if !vestingAmt.IsZero() || vestingStart != 0 || vestingEnd != 0 {
+					return fmt.Errorf("--%s cannot be used with the other vesting parameters", flagVestingPeriods)
+				}
+
+				startTime, periods, err := vestingcli.ParsePeriodsFile(vestingPeriodsFile)
+				if err != nil {
+					return err
+				}
+				if err := vestingtypes.ValidatePeriods(periods); err != nil {
+					return err
+				}
+
+				periodicAccount := authvesting.NewPeriodicVestingAccount(baseAccount, vestingtypes.TotalAmount(periods).Sort(), startTime, periods)
+				if periodicAccount.OriginalVesting.IsAnyGT(balances.Coins) {
+					return errors.New("vesting amount cannot be greater than total amount")
+				}
+				genAccount = periodicAccount
+			} else if !vestingAmt.IsZero() {
 				baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
 
 				if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
@@ -174,6 +198,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriods, "", "periods JSON file for periodic vesting accounts")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
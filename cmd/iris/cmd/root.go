@@ -17,7 +17,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
-	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -81,22 +80,40 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd(),
 	)
 
-	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createIrisappAndExport, addModuleInitFlags)
+	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, nil, addModuleInitFlags)
+	replaceCommand(rootCmd, ExportCmd(app.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		ConfigCmd(),
 		Commands(app.DefaultNodeHome),
 	)
 }
 
+func debugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(StoreDiffCmd())
+
+	// the conversion is nested under the addr command, which still converts between hex and bech32
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "addr" {
+			subCmd.AddCommand(AddrConvertCmd())
+		}
+	}
+	return cmd
+}
+
 func addModuleInitFlags(rootCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(rootCmd)
+	app.AddTxRateLimitFlags(rootCmd)
+	app.AddStreamingFlags(rootCmd)
+	app.AddSQLSinkFlags(rootCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -115,10 +132,12 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		SQLQueryCmd(app.DefaultNodeHome),
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.PersistentFlags().Bool(flagDisplayMainUnit, false, "Display the coins in the response in the main units of the tokens")
 
 	return cmd
 }
@@ -195,24 +214,3 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 	)
 }
-
-// createIrisappAndExport creates a new irisapp (optionally at a given height)
-// and exports state.
-func createIrisappAndExport(
-	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string,
-	appOpts servertypes.AppOptions) (servertypes.ExportedApp, error) {
-	encCfg := app.MakeEncodingConfig() // Ideally, we would reuse the one created by NewRootCmd.
-	encCfg.Marshaler = codec.NewProtoCodec(encCfg.InterfaceRegistry)
-	var irisApp *app.IrisApp
-	if height != -1 {
-		irisApp = app.NewIrisApp(logger, db, traceStore, false, map[int64]bool{}, "", uint(1), encCfg, appOpts)
-
-		if err := irisApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
-		}
-	} else {
-		irisApp = app.NewIrisApp(logger, db, traceStore, true, map[int64]bool{}, "", uint(1), encCfg, appOpts)
-	}
-
-	return irisApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
-}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	// register the sqlite3 driver
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/irisnet/irishub/app"
+)
+
+const (
+	flagDB = "db"
+)
+
+// SQLQueryCmd returns the command querying the local database of the SQL event sink
+func SQLQueryCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sql [query]",
+		Short: "Query the local database of the SQL event sink",
+		Long: `Run a read-only SQL query against the SQLite database written by the SQL event sink of the node
+and print the resulting rows. The sink is enabled by the sql-sink.enable option of app.toml.
+
+The database contains the tables blocks, txs, messages, events, attributes, transfers,
+mint_provisions and guardian_changes.`,
+		Example: `$ iris q sql "SELECT height, amount FROM mint_provisions ORDER BY height DESC LIMIT 10"
+$ iris q sql "SELECT hash, code FROM txs WHERE height = 100" --db /path/to/events.db`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{annotationRawOutput: ""},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, _ := cmd.Flags().GetString(flagDB)
+			if path == "" {
+				home := client.GetClientContextFromCmd(cmd).HomeDir
+				if home == "" {
+					home = defaultNodeHome
+				}
+				path = app.DefaultSQLSinkPath(home)
+			}
+			if _, err := os.Stat(path); err != nil {
+				return fmt.Errorf("no sink database found at %s: %w", path, err)
+			}
+
+			db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro&_busy_timeout=5000", path))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			rows, err := db.Query(args[0])
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+
+			columns, err := rows.Columns()
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, strings.Join(columns, "\t"))
+
+			values := make([]interface{}, len(columns))
+			for i := range values {
+				values[i] = new(interface{})
+			}
+			for rows.Next() {
+				if err := rows.Scan(values...); err != nil {
+					return err
+				}
+				fields := make([]string, len(values))
+				for i, value := range values {
+					fields[i] = formatSQLValue(*value.(*interface{}))
+				}
+				fmt.Fprintln(w, strings.Join(fields, "\t"))
+			}
+			if err := rows.Err(); err != nil {
+				return err
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flagDB, "", "Path of the sink database, defaults to data/events.db in the home directory")
+
+	return cmd
+}
+
+func formatSQLValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
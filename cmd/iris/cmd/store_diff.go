@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/app"
+)
+
+const (
+	flagStores = "stores"
+)
+
+// StoreDiffCmd returns the command comparing the stores of two node data directories
+func StoreDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-diff [home-a] [home-b]",
+		Short: "Compare the application stores of two nodes at a height",
+		Long: `Compare the application stores of two nodes at a height and print the differing keys.
+
+The values are decoded by the store decoders of the modules if available, and printed as hex otherwise.
+The nodes must be stopped, or the data directories copied, as the application databases are opened directly.`,
+		Example: "$ iris debug store-diff ~/.iris-a ~/.iris-b --height 100 --stores mint,bank",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+
+			appA, err := openApp(args[0])
+			if err != nil {
+				return err
+			}
+			appB, err := openApp(args[1])
+			if err != nil {
+				return err
+			}
+
+			if height <= 0 {
+				height = appA.LastBlockHeight()
+				if appB.LastBlockHeight() < height {
+					height = appB.LastBlockHeight()
+				}
+			}
+
+			diffs, err := app.DiffStores(appA, appB, height, stores...)
+			if err != nil {
+				return err
+			}
+
+			counts := make(map[string]int)
+			for _, diff := range diffs {
+				counts[diff.StoreKey]++
+				cmd.Println(diff.String())
+			}
+
+			cmd.Printf("%d differing keys at height %d\n", len(diffs), height)
+			for _, name := range appA.StoreKeys() {
+				if counts[name] > 0 {
+					cmd.Printf("  %s: %d\n", name, counts[name])
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.SetOut(cmd.OutOrStdout())
+	cmd.Flags().Int64(server.FlagHeight, 0, "Height to compare the stores at, defaults to the latest height of both nodes")
+	cmd.Flags().StringSlice(flagStores, []string{}, "Comma-separated list of store keys to compare, all the stores are compared if empty")
+
+	return cmd
+}
+
+// openApp opens the application database of the node home directory at the latest height
+func openApp(home string) (*app.IrisApp, error) {
+	dir := filepath.Join(home, "data")
+	if _, err := os.Stat(filepath.Join(dir, "application.db")); err != nil {
+		return nil, fmt.Errorf("no application database found in %s: %w", home, err)
+	}
+
+	db, err := sdk.NewLevelDB("application", dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the application database of %s: %w", home, err)
+	}
+
+	return app.NewIrisApp(
+		log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, uint(1), app.MakeEncodingConfig(), viper.New(),
+	), nil
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+const (
+	// tokenCacheFile is the file caching the token metadata in the config directory of the client home
+	tokenCacheFile = "tokens.json"
+
+	// tokenCacheTTL is the duration after which the cached token metadata is taken as stale
+	tokenCacheTTL = 7 * 24 * time.Hour
+)
+
+// cachedToken defines the token metadata needed to convert the denoms
+type cachedToken struct {
+	Symbol    string    `json:"symbol"`
+	MinUnit   string    `json:"min_unit"`
+	Scale     uint32    `json:"scale"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// token returns the token of the cached metadata
+func (t cachedToken) token() tokentypes.TokenI {
+	return &tokentypes.Token{
+		Symbol:  t.Symbol,
+		MinUnit: t.MinUnit,
+		Scale:   t.Scale,
+	}
+}
+
+// isStale returns true if the metadata is not updated within the TTL
+func (t cachedToken) isStale(now time.Time) bool {
+	return now.Sub(t.UpdatedAt) > tokenCacheTTL
+}
+
+// tokenCache caches the token metadata in the client home, so that the denoms can be converted offline
+type tokenCache struct {
+	path   string
+	Tokens map[string]cachedToken `json:"tokens"`
+}
+
+// loadTokenCache loads the token cache of the client home, a missing or broken file gives an empty cache
+func loadTokenCache(home string) *tokenCache {
+	cache := &tokenCache{
+		path:   filepath.Join(home, "config", tokenCacheFile),
+		Tokens: make(map[string]cachedToken),
+	}
+
+	bz, err := ioutil.ReadFile(cache.path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(bz, cache); err != nil || cache.Tokens == nil {
+		cache.Tokens = make(map[string]cachedToken)
+	}
+	return cache
+}
+
+// get returns the cached metadata of the token with the given symbol or min unit
+func (c *tokenCache) get(denom string) (cachedToken, bool) {
+	if t, ok := c.Tokens[denom]; ok {
+		return t, true
+	}
+	for _, t := range c.Tokens {
+		if t.MinUnit == denom {
+			return t, true
+		}
+	}
+	return cachedToken{}, false
+}
+
+// set caches the metadata of the token, updated at the given time
+func (c *tokenCache) set(token tokentypes.TokenI, updatedAt time.Time) {
+	c.Tokens[token.GetSymbol()] = cachedToken{
+		Symbol:    token.GetSymbol(),
+		MinUnit:   token.GetMinUnit(),
+		Scale:     token.GetScale(),
+		UpdatedAt: updatedAt.UTC(),
+	}
+}
+
+// save writes the cache to the client home
+func (c *tokenCache) save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, bz, 0644)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+func TestTokenCache(t *testing.T) {
+	home, err := ioutil.TempDir("", "token-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	cache := loadTokenCache(home)
+	require.Empty(t, cache.Tokens)
+
+	now := time.Now()
+	cache.set(&tokentypes.Token{Symbol: "iris", MinUnit: "uiris", Scale: 6}, now)
+	require.NoError(t, cache.save())
+
+	cache = loadTokenCache(home)
+	token, found := cache.get("uiris")
+	require.True(t, found)
+	require.Equal(t, "iris", token.Symbol)
+	require.Equal(t, uint32(6), token.Scale)
+	require.False(t, token.isStale(now))
+	require.True(t, token.isStale(now.Add(tokenCacheTTL+time.Second)))
+
+	_, found = cache.get("atom")
+	require.False(t, found)
+}
+
+func TestQueryTokenOffline(t *testing.T) {
+	home, err := ioutil.TempDir("", "token-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	cache := loadTokenCache(home)
+	cache.set(&tokentypes.Token{Symbol: "iris", MinUnit: "uiris", Scale: 6}, time.Now())
+	cache.set(&tokentypes.Token{Symbol: "btc", MinUnit: "satoshi", Scale: 8}, time.Now().Add(-2*tokenCacheTTL))
+	require.NoError(t, cache.save())
+
+	testCases := []struct {
+		coin     string
+		expected string
+		warning  bool
+		expErr   bool
+	}{
+		{coin: "1.5iris", expected: "1500000uiris"},
+		{coin: "1btc", expected: "100000000satoshi", warning: true},
+		{coin: "1atom", expErr: true},
+	}
+
+	for _, tc := range testCases {
+		stderr := new(bytes.Buffer)
+		cmd := &cobra.Command{
+			Use: "send",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				srcCoin, err := sdk.ParseDecCoin(tc.coin)
+				require.NoError(t, err)
+
+				coin, err := NewConverter().convertToMinCoin(cmd, srcCoin)
+				if err != nil {
+					return err
+				}
+				require.Equal(t, tc.expected, coin.String())
+				return nil
+			},
+		}
+		flags.AddTxFlagsToCmd(cmd)
+		cmd.SetArgs([]string{"--" + flags.FlagOffline, "--" + flags.FlagGenerateOnly})
+		cmd.SetErr(stderr)
+
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{HomeDir: home})
+		err := cmd.ExecuteContext(ctx)
+		require.Equal(t, tc.expErr, err != nil, tc.coin)
+		require.Equal(t, tc.warning, bytes.Contains(stderr.Bytes(), []byte("WARNING")), tc.coin)
+	}
+}
@@ -1,20 +1,21 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/olebedev/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
-	"github.com/tendermint/tendermint/libs/cli"
-
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,10 +24,13 @@ import (
 )
 
 const (
-	formatJSON     = "json"
 	cmdScopeGlobal = "global"
-	filedTypeMap   = "map"
-	filedTypeArray = "array"
+
+	// flagDisplayMainUnit enables the conversion of the coins in the query responses to the main units
+	flagDisplayMainUnit = "display-main-unit"
+
+	// annotationRawOutput marks the query commands whose output is neither YAML nor JSON and is printed as is
+	annotationRawOutput = "raw-output"
 )
 
 var (
@@ -40,16 +44,7 @@ var (
 			registerCmdWithArgs("staking", "redelegate", 2).
 			registerCmdWithArgs("staking", "unbond", 1).
 			registerCmdWithArgs("distribution", "fund-community-pool", 0).
-			registerCmdWithArgs("gov", "deposit", 1).
-			registerCmdForResponse("bank", "balances", "balances", filedTypeArray).
-			registerCmdForResponse("bank", "total", "supply", filedTypeArray).
-			registerCmdForResponse("gov", "params", "deposit_params.min_deposit", filedTypeArray).
-			registerCmdForResponse("distribution", "validator-outstanding-rewards", "rewards", filedTypeArray).
-			registerCmdForResponses("distribution", "rewards",
-			field{name: "total", typ: filedTypeArray},
-			field{name: "rewards.*.reward", typ: filedTypeArray},
-			field{name: "rewards", typ: filedTypeArray}).
-		registerCmdForResponse("token", "total-burn", "burned_coins", filedTypeArray)
+			registerCmdWithArgs("gov", "deposit", 1)
 
 	rescueStdout = os.Stdout
 )
@@ -58,7 +53,6 @@ type (
 	field struct {
 		name  string
 		index int
-		typ   string
 	}
 
 	command struct {
@@ -67,11 +61,10 @@ type (
 	}
 )
 
-func (c command) append(name, typ string, index int) command {
+func (c command) append(name string, index int) command {
 	c.fields[name] = field{
 		name:  name,
 		index: index,
-		typ:   typ,
 	}
 	return c
 }
@@ -79,7 +72,9 @@ func (c command) append(name, typ string, index int) command {
 type coinConverter struct {
 	cmds   map[string]command
 	tokens map[string]tokentypes.TokenI
-	r, w   *os.File
+	cache  *tokenCache
+	w      *os.File
+	out    chan []byte
 }
 
 // NewConverter return a instance of coinConverter
@@ -90,10 +85,6 @@ func NewConverter() *coinConverter {
 	}
 }
 
-func (it *coinConverter) key(parentCmd, cmd string) string {
-	return fmt.Sprintf("%s/%s", parentCmd, cmd)
-}
-
 func (it *coinConverter) registerCmdWithArgs(parentCmd, cmd string, argsIdx int) *coinConverter {
 	commands, ok := it.cmds[cmd]
 	if !ok {
@@ -102,7 +93,7 @@ func (it *coinConverter) registerCmdWithArgs(parentCmd, cmd string, argsIdx int)
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append("ARGS", "", argsIdx)
+	commands = commands.append("ARGS", argsIdx)
 	it.cmds[cmd] = commands
 	return it
 }
@@ -115,7 +106,7 @@ func (it *coinConverter) registerGlobalFlag(flagNm string) *coinConverter {
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append(flagNm, "", -1)
+	commands = commands.append(flagNm, -1)
 	it.cmds[cmdScopeGlobal] = commands
 	return it
 }
@@ -128,39 +119,7 @@ func (it *coinConverter) registerCmdWithFlag(parentCmd, cmd, flagNm string) *coi
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append(flagNm, "", -1)
-	it.cmds[cmd] = commands
-	return it
-}
-
-func (it *coinConverter) registerCmdForResponse(parentCmd, cmd, jsonPath, typ string) *coinConverter {
-	commands, ok := it.cmds[cmd]
-	if !ok {
-		commands = command{
-			parentCmd: parentCmd,
-			fields:    map[string]field{},
-		}
-	}
-
-	commands = commands.append(jsonPath, typ, -1)
-
-	it.cmds[cmd] = commands
-	return it
-}
-
-func (it *coinConverter) registerCmdForResponses(parentCmd, cmd string, fields ...field) *coinConverter {
-	commands, ok := it.cmds[cmd]
-	if !ok {
-		commands = command{
-			parentCmd: parentCmd,
-			fields:    map[string]field{},
-		}
-	}
-
-	for _, field := range fields {
-		commands = commands.append(field.name, field.typ, -1)
-	}
-
+	commands = commands.append(flagNm, -1)
 	it.cmds[cmd] = commands
 	return it
 }
@@ -188,18 +147,7 @@ func (it coinConverter) getFromArgs(cmdNm string) (field, bool) {
 	return cmd.fields["ARGS"], true
 }
 
-func (it coinConverter) getFields(cmdNm string) map[string]field {
-	cmd, ok := it.cmds[cmdNm]
-	if !ok {
-		return map[string]field{}
-	}
-	return cmd.fields
-}
-
 func (it *coinConverter) handlePreRun(cmd *cobra.Command, args []string) {
-	if b, _ := cmd.Flags().GetBool(flags.FlagGenerateOnly); b {
-		return
-	}
 	cmdNm := cmd.Name()
 	//handle flag
 	cmd.Flags().Visit(func(flag *pflag.Flag) {
@@ -212,23 +160,31 @@ func (it *coinConverter) handlePreRun(cmd *cobra.Command, args []string) {
 	//handle field
 	it.parseArgs(cmd, args[:])
 
-	if !it.isOutputYAML(cmd) {
+	if !it.isDisplayMainUnit(cmd) {
+		return
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
 		return
 	}
-	it.r, it.w, _ = os.Pipe()
+	// the output is drained while the command runs, so that a large response never blocks on the pipe
+	it.w, it.out = w, make(chan []byte, 1)
+	go func() {
+		out, _ := ioutil.ReadAll(r)
+		it.out <- out
+	}()
 	os.Stdout = it.w
 }
 
 func (it *coinConverter) handlePostRun(cmd *cobra.Command) {
-	if !it.isOutputYAML(cmd) {
+	if it.w == nil {
 		return
 	}
-	if it.w != nil {
-		_ = it.w.Close()
-	}
-	out, _ := ioutil.ReadAll(it.r)
+	_ = it.w.Close()
+	it.w = nil
+	out := <-it.out
 	os.Stdout = rescueStdout
-	fmt.Println(it.parseYAML(cmd, out))
+	fmt.Println(it.parseOutput(cmd, out))
 }
 
 func (it coinConverter) parseFlags(cmd *cobra.Command, flag *pflag.Flag, cmdNm string) {
@@ -248,52 +204,99 @@ func (it coinConverter) parseArgs(cmd *cobra.Command, args []string) {
 	}
 }
 
-func (it coinConverter) parseYAML(cmd *cobra.Command, in []byte) string {
+// parseOutput converts the coins in the JSON or YAML output to the main units, and keeps the format of the output
+func (it *coinConverter) parseOutput(cmd *cobra.Command, in []byte) string {
+	convert := func(coin sdk.DecCoin) (sdk.DecCoin, error) {
+		return it.convertToMainCoin(cmd, coin)
+	}
+
+	var root interface{}
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.UseNumber()
+	if err := decoder.Decode(&root); err == nil {
+		buf := new(bytes.Buffer)
+		encoder := json.NewEncoder(buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(convertCoinsToMainUnit(root, convert)); err != nil {
+			return string(in)
+		}
+		return strings.TrimSpace(buf.String())
+	}
+
 	cfg, err := config.ParseYamlBytes(in)
 	if err != nil {
 		return string(in)
 	}
-
-	fields := it.getFields(cmd.Name())
-	for path, field := range fields {
-		for _, p := range it.resolvePath(cfg, path) {
-			switch field.typ {
-			case filedTypeArray:
-				it.handleList(cmd, cfg, p)
-			case filedTypeMap:
-				it.handleMap(cmd, cfg, p)
-			}
-		}
-	}
-	s, err := config.RenderYaml(cfg.Root)
+	s, err := config.RenderYaml(convertCoinsToMainUnit(cfg.Root, convert))
 	if err != nil {
 		return string(in)
 	}
-	return s
+	return strings.TrimSpace(s)
 }
 
-func (it coinConverter) resolvePath(cfg *config.Config, path string) (paths []string) {
-	subPaths := strings.SplitN(path, "*", 2)
-	if len(subPaths) == 1 {
-		return []string{path}
+// convertCoinsToMainUnit walks the decoded response and replaces the objects shaped as sdk.Coin or
+// sdk.DecCoin with the ones in the main units. The coins failing to convert are left untouched.
+func convertCoinsToMainUnit(v interface{}, convert func(sdk.DecCoin) (sdk.DecCoin, error)) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if coin, ok := parseCoinObject(value); ok {
+			if dstCoin, err := convert(coin); err == nil {
+				return map[string]interface{}{
+					"denom":  dstCoin.Denom,
+					"amount": dstCoin.Amount.String(),
+				}
+			}
+			return value
+		}
+		for k, elem := range value {
+			value[k] = convertCoinsToMainUnit(elem, convert)
+		}
+		return value
+	case []interface{}:
+		for i, elem := range value {
+			value[i] = convertCoinsToMainUnit(elem, convert)
+		}
+		return value
+	default:
+		return value
 	}
+}
 
-	if len(subPaths) != 2 {
-		return paths
+// parseCoinObject returns the coin of the object made of exactly a denom and an amount
+func parseCoinObject(obj map[string]interface{}) (sdk.DecCoin, bool) {
+	if len(obj) != 2 {
+		return sdk.DecCoin{}, false
+	}
+	denom, ok := obj["denom"].(string)
+	if !ok {
+		return sdk.DecCoin{}, false
 	}
 
-	list, err := cfg.List(subPaths[0][:len(subPaths[0])-1])
+	var amount sdk.Dec
+	var err error
+	switch a := obj["amount"].(type) {
+	case string:
+		amount, err = sdk.NewDecFromStr(a)
+	case json.Number:
+		amount, err = sdk.NewDecFromStr(a.String())
+	case int:
+		amount = sdk.NewDec(int64(a))
+	default:
+		return sdk.DecCoin{}, false
+	}
 	if err != nil {
-		return paths
+		return sdk.DecCoin{}, false
 	}
 
-	for i := 0; i < len(list); i++ {
-		p := fmt.Sprintf("%s%d%s", subPaths[0], i, subPaths[1])
-		paths = append(paths, it.resolvePath(cfg, p)...)
+	coin := sdk.DecCoin{Denom: denom, Amount: amount}
+	if !coin.IsValid() {
+		return sdk.DecCoin{}, false
 	}
-	return paths
+	return coin, true
 }
 
+// queryToken returns the token of the denom from the local cache, or from the node if it is missing or stale
+// in the cache. The token queried from the node updates the cache, and the stale one is still used offline.
 func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokentypes.TokenI, err error) {
 	if ft, ok := it.tokens[denom]; ok {
 		return ft, nil
@@ -308,6 +311,44 @@ func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokent
 		return nil, err
 	}
 
+	if it.cache == nil {
+		it.cache = loadTokenCache(clientCtx.HomeDir)
+	}
+
+	now := time.Now()
+	cached, found := it.cache.get(denom)
+	if found && !cached.isStale(now) {
+		it.tokens[denom] = cached.token()
+		return it.tokens[denom], nil
+	}
+
+	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); !offline {
+		if ft, err = it.queryTokenFromNode(clientCtx, denom); err == nil {
+			it.cache.set(ft, now)
+			if err := it.cache.save(); err != nil {
+				cmd.PrintErrf("WARNING: failed to update the token cache: %s\n", err)
+			}
+			it.tokens[denom] = ft
+			return ft, nil
+		}
+	}
+
+	if !found {
+		if err == nil {
+			err = fmt.Errorf("token %s not found in the local cache", denom)
+		}
+		return nil, err
+	}
+
+	cmd.PrintErrf(
+		"WARNING: the cached scale of token %s was updated at %s, run `iris config tokens sync` to refresh it\n",
+		cached.Symbol, cached.UpdatedAt.Format(time.RFC3339),
+	)
+	it.tokens[denom] = cached.token()
+	return it.tokens[denom], nil
+}
+
+func (it *coinConverter) queryTokenFromNode(clientCtx client.Context, denom string) (tokentypes.TokenI, error) {
 	queryClient := tokentypes.NewQueryClient(clientCtx)
 
 	res, err := queryClient.Token(context.Background(), &tokentypes.QueryTokenRequest{
@@ -318,60 +359,21 @@ func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokent
 	}
 
 	var evi tokentypes.TokenI
-	err = clientCtx.InterfaceRegistry.UnpackAny(res.Token, &evi)
-	if err != nil {
+	if err := clientCtx.InterfaceRegistry.UnpackAny(res.Token, &evi); err != nil {
 		return nil, err
 	}
-
-	it.tokens[denom] = evi
 	return evi, nil
 }
 
-func (it *coinConverter) isOutputYAML(cmd *cobra.Command) bool {
-	output1, err := cmd.Flags().GetString(cli.OutputFlag)
-	output2 := viper.GetString(cli.OutputFlag)
-	if output2 == formatJSON || (err == nil && output1 == formatJSON) {
+// isDisplayMainUnit returns true if the query command has the response converted to the main units
+func (it *coinConverter) isDisplayMainUnit(cmd *cobra.Command) bool {
+	if b, err := cmd.Flags().GetBool(flagDisplayMainUnit); err != nil || !b {
 		return false
 	}
-	cmdPath := cmd.CommandPath()
-	if !strings.Contains(cmdPath, queryCommand().CommandPath()) {
+	if _, ok := cmd.Annotations[annotationRawOutput]; ok {
 		return false
 	}
-	return true
-}
-
-func (it *coinConverter) handleList(cmd *cobra.Command, cfg *config.Config, path string) {
-	list, err := cfg.List(path)
-	if err != nil {
-		return
-	}
-	for i := range list {
-		it.handleMap(cmd, cfg, fmt.Sprintf("%s.%d", path, i))
-	}
-}
-
-func (it *coinConverter) handleMap(cmd *cobra.Command, cfg *config.Config, path string) {
-	cMap, err := cfg.Map(path)
-	if err != nil {
-		return
-	}
-
-	bz, err := json.Marshal(cMap)
-	if err != nil {
-		return
-	}
-
-	var srcCoin sdk.DecCoin
-	if err := json.Unmarshal(bz, &srcCoin); err != nil || !srcCoin.IsValid() {
-		return
-	}
-
-	truncCoin, _ := srcCoin.TruncateDecimal()
-	dstCoin, err := it.convertToMainCoin(cmd, truncCoin)
-	if err != nil {
-		return
-	}
-	_ = cfg.Set(path, dstCoin)
+	return strings.Contains(cmd.CommandPath(), queryCommand().CommandPath())
 }
 
 func (it *coinConverter) convertCoins(cmd *cobra.Command, coinsStr string) (dstCoinsStr string, err error) {
@@ -399,12 +401,26 @@ func (it *coinConverter) convertToMinCoin(cmd *cobra.Command, srcCoin sdk.DecCoi
 	return ft.ToMinCoin(srcCoin)
 }
 
-func (it *coinConverter) convertToMainCoin(cmd *cobra.Command, srcCoin sdk.Coin) (coin sdk.DecCoin, err error) {
+// convertToMainCoin converts the coin to the main unit. The decimal amounts, e.g. of the rewards,
+// are divided by the scale as they are, so that the fractions of the min units are kept
+func (it *coinConverter) convertToMainCoin(cmd *cobra.Command, srcCoin sdk.DecCoin) (coin sdk.DecCoin, err error) {
 	ft, err := it.queryToken(cmd, srcCoin.Denom)
 	if err != nil {
 		return coin, err
 	}
-	return ft.ToMainCoin(srcCoin)
+	if srcCoin.Amount.IsInteger() {
+		return ft.ToMainCoin(sdk.NewCoin(srcCoin.Denom, srcCoin.Amount.TruncateInt()))
+	}
+
+	switch srcCoin.Denom {
+	case ft.GetSymbol():
+		return srcCoin, nil
+	case ft.GetMinUnit():
+		precision := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(ft.GetScale())), nil)
+		return sdk.NewDecCoinFromDec(ft.GetSymbol(), srcCoin.Amount.Quo(sdk.NewDecFromBigInt(precision))), nil
+	default:
+		return coin, fmt.Errorf("token %s not matching denom %s", ft.GetSymbol(), srcCoin.Denom)
+	}
 }
 
 func (it *coinConverter) parseCoins(srcCoinsStr string) (sdk.DecCoins, error) {
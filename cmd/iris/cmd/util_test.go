@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+func TestConvertCoinsToMainUnit(t *testing.T) {
+	convert := func(coin sdk.DecCoin) (sdk.DecCoin, error) {
+		if coin.Denom != "uiris" {
+			return sdk.DecCoin{}, fmt.Errorf("unknown token: %s", coin.Denom)
+		}
+		return sdk.NewDecCoinFromDec("iris", coin.Amount.QuoInt64(1000000)), nil
+	}
+
+	jsonOut := `{"balances":[{"denom":"uiris","amount":"1500000"},{"denom":"ibc/ABC","amount":"7"}],` +
+		`"rewards":[{"validator_address":"iva1","reward":[{"denom":"uiris","amount":"2000000.9"}]}],` +
+		`"params":{"denom":"uiris","amount":"1000000","enabled":true}}`
+
+	var root interface{}
+	require.NoError(t, json.Unmarshal([]byte(jsonOut), &root))
+	root = convertCoinsToMainUnit(root, convert)
+
+	m := root.(map[string]interface{})
+	balances := m["balances"].([]interface{})
+	require.Equal(t, map[string]interface{}{"denom": "iris", "amount": "1.500000000000000000"}, balances[0])
+	require.Equal(t, map[string]interface{}{"denom": "ibc/ABC", "amount": "7"}, balances[1])
+
+	reward := m["rewards"].([]interface{})[0].(map[string]interface{})["reward"].([]interface{})
+	require.Equal(t, map[string]interface{}{"denom": "iris", "amount": "2.000000900000000000"}, reward[0])
+
+	// the objects with other fields than the denom and amount are not coins
+	require.Equal(t, "uiris", m["params"].(map[string]interface{})["denom"])
+
+	yamlOut := "pagination:\n  next_key: null\nsupply:\n- amount: \"3000000\"\n  denom: uiris\n"
+	cfg, err := config.ParseYamlBytes([]byte(yamlOut))
+	require.NoError(t, err)
+	supply := convertCoinsToMainUnit(cfg.Root, convert).(map[string]interface{})["supply"].([]interface{})
+	require.Equal(t, map[string]interface{}{"denom": "iris", "amount": "3.000000000000000000"}, supply[0])
+}
+
+func TestConvertToMainCoin(t *testing.T) {
+	home, err := ioutil.TempDir("", "token-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	cache := loadTokenCache(home)
+	cache.set(&tokentypes.Token{Symbol: "iris", MinUnit: "uiris", Scale: 6}, time.Now())
+	require.NoError(t, cache.save())
+
+	testCases := []struct {
+		coin     string
+		expected string
+	}{
+		{coin: "1500000uiris", expected: "1.500000000000000000iris"},
+		{coin: "2000000.9uiris", expected: "2.000000900000000000iris"},
+		{coin: "0.000001uiris", expected: "0.000000000001000000iris"},
+		{coin: "1.5iris", expected: "1.500000000000000000iris"},
+	}
+
+	for _, tc := range testCases {
+		cmd := &cobra.Command{
+			Use: "query",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				srcCoin, err := sdk.ParseDecCoin(tc.coin)
+				require.NoError(t, err)
+
+				coin, err := NewConverter().convertToMainCoin(cmd, srcCoin)
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, coin.String(), tc.coin)
+				return nil
+			},
+		}
+		flags.AddQueryFlagsToCmd(cmd)
+		cmd.Flags().Bool(flags.FlagOffline, true, "")
+
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{HomeDir: home})
+		require.NoError(t, cmd.ExecuteContext(ctx))
+	}
+}
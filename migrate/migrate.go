@@ -196,6 +196,8 @@ func migrateAuth(initialState v0_16.GenesisFileState, bondedTokens, notBondedTok
 			}
 			coins = sdk.NewCoins(coins...)
 		}
+		// v0.16 has no vesting accounts, so the vesting account types (periodic included) never
+		// show up here: every non-module account migrates to a base account
 		var account authtypes.GenesisAccount
 		baseAccount := authtypes.NewBaseAccount(acc.Address, nil, acc.AccountNumber, acc.Sequence)
 
@@ -531,17 +533,20 @@ func migrateTransfer() *transfertypes.GenesisState {
 
 func migrateMint(initialState v0_16.GenesisFileState) *minttypes.GenesisState {
 	minter := minttypes.Minter{
-		LastUpdate:    initialState.MintData.Minter.LastUpdate,
-		InflationBase: initialState.MintData.Minter.InflationBase.Quo(Precision),
-	}
-	params := minttypes.Params{
-		Inflation: initialState.MintData.Params.Inflation,
-		MintDenom: UIRIS,
-	}
+		Denom:      UIRIS,
+		LastUpdate: initialState.MintData.Minter.LastUpdate,
+	}
+	params := minttypes.NewParams(
+		minttypes.MintSchedule{
+			Denom:         UIRIS,
+			Inflation:     initialState.MintData.Params.Inflation,
+			InflationBase: initialState.MintData.Minter.InflationBase.Quo(Precision),
+		},
+	)
 
 	return &minttypes.GenesisState{
-		Minter: minter,
-		Params: params,
+		Minters: []minttypes.Minter{minter},
+		Params:  params,
 	}
 }
 
@@ -617,6 +622,15 @@ func migrateGuardian(initialState v0_16.GenesisFileState) *guardiantypes.Genesis
 
 	return &guardiantypes.GenesisState{
 		Supers: supers,
+		Params: guardiantypes.NewParams(
+			guardiantypes.DefaultParams().MaxRepeatedTotal,
+			guardiantypes.DefaultParams().MinRepeatedFrequency,
+			sdk.NewCoins(sdk.NewCoin(UIRIS, sdk.NewIntWithDecimal(10000, 6))),
+			guardiantypes.DefaultParams().FeeDenoms,
+			guardiantypes.DefaultParams().MaxFeeSlippage,
+			guardiantypes.DefaultParams().MinFeePoolLiquidity,
+			guardiantypes.DefaultParams().MsgMinGasPrices,
+		),
 	}
 }
 
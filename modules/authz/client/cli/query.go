@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for the authz module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryGrants(),
+		GetCmdQueryGranteeGrants(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryGrants implements the query grants command.
+func GetCmdQueryGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grants [granter] [grantee] [msg-type-url]?",
+		Short:   "Query the authorizations granted to the grantee by the granter, optionally of the msg type only",
+		Example: fmt.Sprintf("%s query authz grants <granter> <grantee> /irismod.oracle.MsgEditFeed", version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			var msgTypeURL string
+			if len(args) > 2 {
+				msgTypeURL = args[2]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Grants(context.Background(), &types.QueryGrantsRequest{
+				Granter:    args[0],
+				Grantee:    args[1],
+				MsgTypeUrl: msgTypeURL,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+	return cmd
+}
+
+// GetCmdQueryGranteeGrants implements the query grantee grants command.
+func GetCmdQueryGranteeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grantee-grants [grantee]",
+		Short:   "Query all the authorizations granted to the grantee",
+		Example: fmt.Sprintf("%s query authz grantee-grants <grantee>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GranteeGrants(context.Background(), &types.QueryGranteeGrantsRequest{
+				Grantee:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+	return cmd
+}
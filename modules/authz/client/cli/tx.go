@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	"github.com/irisnet/irishub/modules/authz/types"
+)
+
+const (
+	FlagMsgType    = "msg-type"
+	FlagSpendLimit = "spend-limit"
+	FlagExpiration = "expiration"
+)
+
+// authorization types of the grant command
+const (
+	AuthorizationGeneric = "generic"
+	AuthorizationSend    = "send"
+)
+
+// NewTxCmd returns the transaction commands for the authz module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "authz transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdGrant(),
+		GetCmdRevoke(),
+		GetCmdExec(),
+	)
+	return txCmd
+}
+
+// GetCmdGrant implements the grant command.
+func GetCmdGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [generic|send]",
+		Short: "Grant an authorization to execute msgs on behalf of the sender",
+		Long: `Grant an authorization to the grantee to execute msgs on behalf of the sender. A generic authorization
+allows any msg of the type given by --msg-type, a send authorization allows bank sends up to --spend-limit.`,
+		Example: fmt.Sprintf(
+			"%s tx authz grant <grantee> generic --msg-type=/irismod.oracle.MsgEditFeed --expiration=2022-01-30T15:04:05Z "+
+				"--chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case AuthorizationGeneric:
+				msgType, _ := cmd.Flags().GetString(FlagMsgType)
+				authorization = types.NewGenericAuthorization(msgType)
+			case AuthorizationSend:
+				spendLimitStr, _ := cmd.Flags().GetString(FlagSpendLimit)
+				spendLimit, err := sdk.ParseCoinsNormalized(spendLimitStr)
+				if err != nil {
+					return err
+				}
+				authorization = types.NewSendAuthorization(spendLimit)
+			default:
+				return fmt.Errorf("invalid authorization type %s, expected %s or %s", args[1], AuthorizationGeneric, AuthorizationSend)
+			}
+
+			var expiration *time.Time
+			if expirationStr, _ := cmd.Flags().GetString(FlagExpiration); len(expirationStr) > 0 {
+				parsed, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				expiration = &parsed
+			}
+
+			msg, err := types.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagMsgType, "", "Type url of the msgs allowed by a generic authorization")
+	cmd.Flags().String(FlagSpendLimit, "", "Maximum amount which can be sent with a send authorization")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires, if not mentioned the grant does not expire")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRevoke implements the revoke command.
+func GetCmdRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type-url]",
+		Short: "Revoke the authorization of the msg type granted to the grantee",
+		Example: fmt.Sprintf(
+			"%s tx authz revoke <grantee> /irismod.oracle.MsgEditFeed --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(clientCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExec implements the exec command.
+func GetCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the msgs of a tx on behalf of their signers",
+		Long: `Execute the msgs of an unsigned tx, e.g. generated with --generate-only, on behalf of their signers.
+The msgs whose signer is not the sender must be allowed by the authorizations granted to the sender.`,
+		Example: fmt.Sprintf(
+			"%s tx authz exec tx.json --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			execTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExec(clientCtx.GetFromAddress(), execTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
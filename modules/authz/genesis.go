@@ -0,0 +1,42 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/authz/keeper"
+	"github.com/irisnet/irishub/modules/authz/types"
+)
+
+// InitGenesis stores genesis data, the expired grants are discarded
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize authz genesis state: %s", err.Error()))
+	}
+
+	for _, entry := range data.Authorization {
+		grant := entry.GetGrant()
+		if grant.IsExpired(ctx) {
+			continue
+		}
+
+		granter, _ := sdk.AccAddressFromBech32(entry.Granter)
+		grantee, _ := sdk.AccAddressFromBech32(entry.Grantee)
+		k.SetGrant(ctx, granter, grantee, grant)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(
+		ctx,
+		func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+			entries = append(entries, types.NewGrantAuthorization(granter, grantee, grant))
+			return false
+		},
+	)
+
+	return types.NewGenesisState(entries)
+}
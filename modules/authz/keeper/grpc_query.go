@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Grants implements the Query/Grants gRPC method
+func (k Keeper) Grants(c context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address: %v", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if len(req.MsgTypeUrl) > 0 {
+		grant, found := k.GetGrant(ctx, granter, grantee, req.MsgTypeUrl)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no grant of %s for granter %s and grantee %s", req.MsgTypeUrl, req.Granter, req.Grantee)
+		}
+		return &types.QueryGrantsResponse{Grants: []*types.Grant{&grant}}, nil
+	}
+
+	var grants []*types.Grant
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGrantPrefix(granter, grantee))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+		grants = append(grants, &grant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+// GranteeGrants implements the Query/GranteeGrants gRPC method
+func (k Keeper) GranteeGrants(c context.Context, req *types.QueryGranteeGrantsRequest) (*types.QueryGranteeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var grants []*types.GrantAuthorization
+	prefixKey := types.GetGranteeIndexPrefix(grantee)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		_, granter, msgTypeURL := types.ParseGranteeIndexKey(append(append([]byte{}, prefixKey...), key...))
+		grant, found := k.GetGrant(ctx, granter, grantee, msgTypeURL)
+		if !found {
+			return status.Errorf(codes.Internal, "no grant of the index key %X", key)
+		}
+		grantAuthorization := types.NewGrantAuthorization(granter, grantee, grant)
+		grants = append(grants, &grantAuthorization)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryGranteeGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
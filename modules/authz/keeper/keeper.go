@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/authz/types"
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+// Keeper of the authz store
+type Keeper struct {
+	cdc      codec.Marshaler
+	storeKey sdk.StoreKey
+	router   msgrouter.Router
+}
+
+// NewKeeper returns an authz keeper. The msgs are executed by the Msg service router on behalf of their signers
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, router *baseapp.MsgServiceRouter, registry codectypes.InterfaceRegistry) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+		router:   msgrouter.NewRouter(router, registry),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// SaveGrant grants the authorization to the grantee until the optional expiration,
+// the existing grant of the same msg type is replaced
+func (k Keeper) SaveGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration *time.Time,
+) error {
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiration %s must be after the block time %s", expiration, ctx.BlockTime())
+	}
+
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+	k.SetGrant(ctx, granter, grantee, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+		),
+	)
+
+	return nil
+}
+
+// DeleteGrant revokes the grant of the msg type given by the granter to the grantee
+func (k Keeper) DeleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) error {
+	if _, found := k.GetGrant(ctx, granter, grantee, msgTypeURL); !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorization, "granter %s, grantee %s, msg type %s", granter, grantee, msgTypeURL)
+	}
+	k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+
+	return nil
+}
+
+// GetGrant returns the grant of the msg type given by the granter to the grantee
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGrantKey(granter, grantee, msgTypeURL))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates over all the grants in the store
+func (k Keeper) IterateGrants(ctx sdk.Context, op func(granter, grantee sdk.AccAddress, grant types.Grant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		granter, grantee, _ := types.ParseGrantKey(iterator.Key())
+
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if stop := op(granter, grantee, grant); stop {
+			break
+		}
+	}
+}
+
+// DispatchActions executes the msgs on behalf of their signers. The msgs of the signers other than the
+// grantee are accepted by the grants given to the grantee, which are updated or deleted accordingly.
+// It returns the data of the results of the msgs
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMsg, "msgs must have exactly one signer, got %v", signers)
+		}
+
+		if granter := signers[0]; !granter.Equals(grantee) {
+			if err := k.accept(ctx, granter, grantee, msgrouter.Request(msg)); err != nil {
+				return nil, err
+			}
+		}
+
+		handler, req, err := k.router.Route(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute msg %d", i)
+		}
+
+		results[i] = res.Data
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return results, nil
+}
+
+// accept checks the msg against the grant of its type given by the granter to the grantee
+func (k Keeper) accept(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgTypeURL := msgrouter.TypeURL(msg)
+
+	grant, found := k.GetGrant(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorization, "granter %s, grantee %s, msg type %s", granter, grantee, msgTypeURL)
+	}
+	if grant.IsExpired(ctx) {
+		return sdkerrors.Wrapf(types.ErrAuthorizationExpired, "granter %s, grantee %s, msg type %s", granter, grantee, msgTypeURL)
+	}
+
+	authorization, err := grant.GetAuthorizationI()
+	if err != nil {
+		return err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if !resp.Accept {
+		return sdkerrors.Wrapf(types.ErrNotAccepted, "granter %s, grantee %s, msg type %s", granter, grantee, msgTypeURL)
+	}
+
+	switch {
+	case resp.Delete:
+		k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+	case resp.Updated != nil:
+		updated, err := types.NewGrant(resp.Updated, grant.Expiration)
+		if err != nil {
+			return err
+		}
+		k.SetGrant(ctx, granter, grantee, updated)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+
+	return nil
+}
+
+// SetGrant stores the grant and adds it to the grantee index
+func (k Keeper) SetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, grant types.Grant) {
+	authorization, _ := grant.GetAuthorizationI()
+	msgTypeURL := authorization.MsgTypeURL()
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	store.Set(types.GetGrantKey(granter, grantee, msgTypeURL), bz)
+	store.Set(types.GetGranteeIndexKey(grantee, granter, msgTypeURL), []byte{})
+}
+
+func (k Keeper) deleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGrantKey(granter, grantee, msgTypeURL))
+	store.Delete(types.GetGranteeIndexKey(grantee, granter, msgTypeURL))
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/authz/keeper"
+	"github.com/irisnet/irishub/modules/authz/types"
+	"github.com/irisnet/irishub/msgrouter"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	granter   = sdk.AccAddress([]byte("granter_____________"))
+	grantee   = sdk.AccAddress([]byte("grantee_____________"))
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+
+	blockTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	sendURL   = msgrouter.TypeURL(&banktypes.MsgSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: blockTime})
+	suite.keeper = app.AuthzKeeper
+
+	suite.NoError(app.BankKeeper.SetBalances(suite.ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) send(amount int64) []sdk.Msg {
+	return []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return suite.app.BankKeeper.GetAllBalances(suite.ctx, addr)
+}
+
+func (suite *KeeperTestSuite) TestSaveAndDeleteGrant() {
+	authorization := types.NewGenericAuthorization(sendURL)
+
+	past := blockTime.Add(-time.Second)
+	err := suite.keeper.SaveGrant(suite.ctx, granter, grantee, authorization, &past)
+	suite.ErrorIs(err, types.ErrInvalidExpiration)
+
+	suite.NoError(suite.keeper.SaveGrant(suite.ctx, granter, grantee, authorization, nil))
+	grant, found := suite.keeper.GetGrant(suite.ctx, granter, grantee, sendURL)
+	suite.True(found)
+	suite.Nil(grant.Expiration)
+
+	res, err := suite.keeper.GranteeGrants(sdk.WrapSDKContext(suite.ctx), &types.QueryGranteeGrantsRequest{Grantee: grantee.String()})
+	suite.NoError(err)
+	suite.Len(res.Grants, 1)
+	suite.Equal(granter.String(), res.Grants[0].Granter)
+
+	suite.NoError(suite.keeper.DeleteGrant(suite.ctx, granter, grantee, sendURL))
+	_, found = suite.keeper.GetGrant(suite.ctx, granter, grantee, sendURL)
+	suite.False(found)
+
+	err = suite.keeper.DeleteGrant(suite.ctx, granter, grantee, sendURL)
+	suite.ErrorIs(err, types.ErrNoAuthorization)
+
+	res, err = suite.keeper.GranteeGrants(sdk.WrapSDKContext(suite.ctx), &types.QueryGranteeGrantsRequest{Grantee: grantee.String()})
+	suite.NoError(err)
+	suite.Empty(res.Grants)
+}
+
+func (suite *KeeperTestSuite) TestDispatchGeneric() {
+	// no grant
+	_, err := suite.keeper.DispatchActions(suite.ctx, grantee, suite.send(100))
+	suite.ErrorIs(err, types.ErrNoAuthorization)
+
+	suite.NoError(suite.keeper.SaveGrant(suite.ctx, granter, grantee, types.NewGenericAuthorization(sendURL), nil))
+
+	_, err = suite.keeper.DispatchActions(suite.ctx, grantee, suite.send(100))
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), suite.balance(recipient))
+
+	// the generic grant is kept
+	_, found := suite.keeper.GetGrant(suite.ctx, granter, grantee, sendURL)
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestDispatchSendLimit() {
+	suite.NoError(suite.keeper.SaveGrant(
+		suite.ctx, granter, grantee, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 150))), nil,
+	))
+
+	_, err := suite.keeper.DispatchActions(suite.ctx, grantee, suite.send(100))
+	suite.NoError(err)
+
+	grant, found := suite.keeper.GetGrant(suite.ctx, granter, grantee, sendURL)
+	suite.True(found)
+	authorization, err := grant.GetAuthorizationI()
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), authorization.(*types.SendAuthorization).SpendLimit)
+
+	// the send exceeds the remaining limit
+	_, err = suite.keeper.DispatchActions(suite.ctx, grantee, suite.send(60))
+	suite.Error(err)
+
+	// the grant is deleted once the limit is spent
+	_, err = suite.keeper.DispatchActions(suite.ctx, grantee, suite.send(50))
+	suite.NoError(err)
+	_, found = suite.keeper.GetGrant(suite.ctx, granter, grantee, sendURL)
+	suite.False(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), suite.balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestDispatchExpired() {
+	expiration := blockTime.Add(time.Hour)
+	suite.NoError(suite.keeper.SaveGrant(suite.ctx, granter, grantee, types.NewGenericAuthorization(sendURL), &expiration))
+
+	suite.ctx = suite.ctx.WithBlockTime(expiration)
+	_, err := suite.keeper.DispatchActions(suite.ctx, grantee, suite.send(100))
+	suite.ErrorIs(err, types.ErrAuthorizationExpired)
+	suite.True(suite.balance(recipient).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestDispatchOwnMsgs() {
+	// the msgs signed by the grantee do not require a grant
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))}
+	_, err := suite.keeper.DispatchActions(suite.ctx, granter, msgs)
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), suite.balance(recipient))
+}
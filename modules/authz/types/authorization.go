@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the permissions of the grantee to execute the msgs of a type on behalf of the granter
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type url of the msgs the authorization allows to execute
+	MsgTypeURL() string
+
+	// Accept determines whether the grantee can execute the msg. The msgs of the Msg services are passed
+	// as their requests. The authorization returned in the response replaces the stored one, e.g. when
+	// its limit is consumed by the msg
+	Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic should evaluate the authorization for internal consistency
+	ValidateBasic() error
+}
+
+// AcceptResponse is the result of Authorization.Accept
+type AcceptResponse struct {
+	// Accept is true if the msg can be executed
+	Accept bool
+	// Delete is true if the grant must be deleted, e.g. when its limit is used up
+	Delete bool
+	// Updated is the updated authorization, if any, which is stored in place of the current one
+	Updated Authorization
+}
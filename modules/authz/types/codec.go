@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary module/authz interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrant{}, "irishub/authz/MsgGrant", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "irishub/authz/MsgExec", nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, "irishub/authz/MsgRevoke", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "irishub/authz/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "irishub/authz/SendAuthorization", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrant{},
+		&MsgExec{},
+		&MsgRevoke{},
+	)
+	registry.RegisterInterface(
+		"irishub.authz.Authorization",
+		(*Authorization)(nil),
+		&GenericAuthorization{},
+		&SendAuthorization{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
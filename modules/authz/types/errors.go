@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// authz module sentinel errors
+var (
+	ErrNoAuthorization      = sdkerrors.Register(ModuleName, 2, "authorization not found")
+	ErrAuthorizationExpired = sdkerrors.Register(ModuleName, 3, "authorization expired")
+	ErrSelfGrant            = sdkerrors.Register(ModuleName, 4, "cannot grant authorization to self")
+	ErrInvalidExpiration    = sdkerrors.Register(ModuleName, 5, "invalid expiration")
+	ErrInvalidMsg           = sdkerrors.Register(ModuleName, 6, "invalid msg")
+	ErrNotAccepted          = sdkerrors.Register(ModuleName, 7, "msg not accepted by the authorization")
+)
@@ -0,0 +1,15 @@
+// nolint
+package types
+
+// authz module event types
+const (
+	EventTypeGrant  = "grant"
+	EventTypeRevoke = "revoke"
+	EventTypeExec   = "exec"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization instance
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgTypeURL,
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization, the msgs of the type are always accepted
+func (a GenericAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a GenericAuthorization) ValidateBasic() error {
+	if len(a.Msg) == 0 || a.Msg[0] != '/' {
+		return sdkerrors.Wrapf(ErrInvalidMsg, "invalid msg type url: %q", a.Msg)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = Grant{}
+	_ codectypes.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGrant creates a new Grant instance, the expiration is optional
+func NewGrant(authorization Authorization, expiration *time.Time) (Grant, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on Grant
+func (g Grant) ValidateBasic() error {
+	if g.Expiration != nil && g.Expiration.Unix() < 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiration, "expiration time cannot be negative")
+	}
+
+	authorization, err := g.GetAuthorizationI()
+	if err != nil {
+		return err
+	}
+	return authorization.ValidateBasic()
+}
+
+// GetAuthorizationI returns the Authorization packed in the Grant
+func (g Grant) GetAuthorizationI() (Authorization, error) {
+	return unpackAuthorization(g.Authorization)
+}
+
+// IsExpired returns true if the grant has expired at the block time
+func (g Grant) IsExpired(ctx sdk.Context) bool {
+	return g.Expiration != nil && !g.Expiration.After(ctx.BlockTime())
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization instance
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, grant Grant) GrantAuthorization {
+	return GrantAuthorization{
+		Granter:       granter.String(),
+		Grantee:       grantee.String(),
+		Authorization: grant.Authorization,
+		Expiration:    grant.Expiration,
+	}
+}
+
+// GetGrant returns the Grant of the GrantAuthorization
+func (g GrantAuthorization) GetGrant() Grant {
+	return Grant{
+		Authorization: g.Authorization,
+		Expiration:    g.Expiration,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func packAuthorization(authorization Authorization) (*codectypes.Any, error) {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", authorization)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
+
+func unpackAuthorization(any *codectypes.Any) (Authorization, error) {
+	authorization, ok := any.GetCachedValue().(Authorization)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAuthorization, "failed to get authorization")
+	}
+	return authorization, nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "authz"
+
+	// StoreKey is the default store key for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the authz store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	GrantKeyPrefix        = []byte{0x01} // grant key prefix
+	GranteeIndexKeyPrefix = []byte{0x02} // key prefix of the index of the grants by grantee
+)
+
+// GetGrantKey returns the key of the grant of the msg type given by the granter to the grantee
+func GetGrantKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GetGrantPrefix(granter, grantee), []byte(msgTypeURL)...)
+}
+
+// GetGrantPrefix returns the prefix key of all the grants given by the granter to the grantee
+func GetGrantPrefix(granter, grantee sdk.AccAddress) []byte {
+	return append(append([]byte{}, GrantKeyPrefix...), addressesBytes(granter, grantee)...)
+}
+
+// ParseGrantKey returns the granter, the grantee and the msg type of the grant key
+func ParseGrantKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	key = key[len(GrantKeyPrefix):]
+	granter, key = sdk.AccAddress(key[1:1+key[0]]), key[1+key[0]:]
+	grantee, key = sdk.AccAddress(key[1:1+key[0]]), key[1+key[0]:]
+	return granter, grantee, string(key)
+}
+
+// GetGranteeIndexKey returns the index key of the grant of the msg type given by the granter to the grantee
+func GetGranteeIndexKey(grantee, granter sdk.AccAddress, msgTypeURL string) []byte {
+	key := append(append([]byte{}, GranteeIndexKeyPrefix...), addressesBytes(grantee, granter)...)
+	return append(key, []byte(msgTypeURL)...)
+}
+
+// GetGranteeIndexPrefix returns the prefix of the index keys of all the grants given to the grantee
+func GetGranteeIndexPrefix(grantee sdk.AccAddress) []byte {
+	key := append(append([]byte{}, GranteeIndexKeyPrefix...), byte(len(grantee)))
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseGranteeIndexKey returns the grantee, the granter and the msg type of the grantee index key
+func ParseGranteeIndexKey(key []byte) (grantee, granter sdk.AccAddress, msgTypeURL string) {
+	key = key[len(GranteeIndexKeyPrefix):]
+	grantee, key = sdk.AccAddress(key[1:1+key[0]]), key[1+key[0]:]
+	granter, key = sdk.AccAddress(key[1:1+key[0]]), key[1+key[0]:]
+	return grantee, granter, string(key)
+}
+
+// addressesBytes returns the length prefixed bytes of the addresses
+func addressesBytes(addrs ...sdk.AccAddress) []byte {
+	var bz []byte
+	for _, addr := range addrs {
+		bz = append(bz, byte(len(addr)))
+		bz = append(bz, addr.Bytes()...)
+	}
+	return bz
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+const (
+	TypeMsgGrant  = "grant"  // type for MsgGrant
+	TypeMsgExec   = "exec"   // type for MsgExec
+	TypeMsgRevoke = "revoke" // type for MsgRevoke
+)
+
+var (
+	_ sdk.Msg = &MsgGrant{}
+	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRevoke{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgGrant{}
+	_ codectypes.UnpackInterfacesMessage = MsgExec{}
+)
+
+// NewMsgGrant constructs a MsgGrant, the expiration is optional
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration *time.Time) (*MsgGrant, error) {
+	grant, err := NewGrant(authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   grant,
+	}, nil
+}
+
+// Route implements Msg.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// GetSignBytes implements Msg.
+func (msg MsgGrant) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgGrant) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSigners implements Msg.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// ______________________________________________________________________
+
+// NewMsgExec constructs a MsgExec
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	anys, err := msgrouter.PackMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    anys,
+	}, nil
+}
+
+// Route implements Msg.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// GetSignBytes implements Msg.
+func (msg MsgExec) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsg, "no msgs")
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if len(msg.GetSigners()) != 1 {
+			return sdkerrors.Wrapf(ErrInvalidMsg, "msgs must have exactly one signer, got %v", msg.GetSigners())
+		}
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+// GetMessages returns the unpacked msgs of the message
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	return msgrouter.UnpackMsgs(msg.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msgrouter.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// ______________________________________________________________________
+
+// NewMsgRevoke constructs a MsgRevoke
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgTypeURL string) *MsgRevoke {
+	return &MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// GetSignBytes implements Msg.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRevoke) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if len(msg.MsgTypeUrl) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsg, "missing msg type url")
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func validateGranterGrantee(granterStr, granteeStr string) error {
+	granter, err := sdk.AccAddressFromBech32(granterStr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(granteeStr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if granter.Equals(grantee) {
+		return ErrSelfGrant
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryGrantsResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryGranteeGrantsResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryGrantsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range res.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryGranteeGrantsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range res.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization instance
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a SendAuthorization) MsgTypeURL() string {
+	return msgrouter.TypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization, the amount of the send is subtracted from the spend limit
+// and the grant is deleted once the spend limit is used up
+func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	send, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", send, msg)
+	}
+
+	left, isNegative := a.SpendLimit.SafeSub(send.Amount)
+	if isNegative {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "spend limit %s is smaller than %s", a.SpendLimit, send.Amount)
+	}
+	if left.IsZero() {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: &SendAuthorization{SpendLimit: left}}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit: %s", a.SpendLimit)
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/authz/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("uiris", amount)))
+	}
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("uiris", 100)))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+
+	resp, err := authorization.Accept(ctx, send(30))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("uiris", 70))), resp.Updated)
+
+	_, err = authorization.Accept(ctx, send(101))
+	require.Error(t, err)
+
+	resp, err = authorization.Accept(ctx, send(100))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	_, err = authorization.Accept(ctx, banktypes.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	require.Error(t, types.NewSendAuthorization(sdk.NewCoins()).ValidateBasic())
+}
@@ -0,0 +1,25 @@
+// nolint
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagSpendLimit  = "spend-limit"
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+)
+
+// common flagsets to add to various functions
+var (
+	FsGrantAllowance = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsGrantAllowance.String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
+	FsGrantAllowance.String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
+	FsGrantAllowance.Int64(FlagPeriod, 0, "Period specifies the time duration in seconds in which period-limit coins can be spent before that allowance is reset")
+	FsGrantAllowance.String(FlagPeriodLimit, "", "Period limit specifies the maximum number of coins that can be spent in the period")
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/feegrant/types"
+)
+
+// NewTxCmd returns the transaction commands for the feegrant module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "feegrant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdGrantAllowance(),
+		GetCmdRevokeAllowance(),
+	)
+	return txCmd
+}
+
+// GetCmdGrantAllowance implements the grant allowance command.
+func GetCmdGrantAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant a fee allowance to an address",
+		Long:  "Grant an allowance to the grantee to pay fees from the account of the sender, a periodic allowance is granted if --period and --period-limit are specified.",
+		Example: fmt.Sprintf(
+			"%s tx feegrant grant <grantee> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --spend-limit=100iris --expiration=2022-01-30T15:04:05Z --period=3600 --period-limit=10iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter := clientCtx.GetFromAddress()
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			basic := types.BasicAllowance{}
+
+			spendLimitStr, _ := cmd.Flags().GetString(FlagSpendLimit)
+			if len(spendLimitStr) > 0 {
+				spendLimit, err := sdk.ParseCoinsNormalized(spendLimitStr)
+				if err != nil {
+					return err
+				}
+				basic.SpendLimit = spendLimit
+			}
+
+			expirationStr, _ := cmd.Flags().GetString(FlagExpiration)
+			if len(expirationStr) > 0 {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				basic.Expiration = &expiration
+			}
+
+			var allowance types.FeeAllowanceI = &basic
+
+			period, _ := cmd.Flags().GetInt64(FlagPeriod)
+			periodLimitStr, _ := cmd.Flags().GetString(FlagPeriodLimit)
+			if period > 0 || len(periodLimitStr) > 0 {
+				if period <= 0 || len(periodLimitStr) == 0 {
+					return fmt.Errorf("--%s and --%s must be specified together", FlagPeriod, FlagPeriodLimit)
+				}
+
+				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitStr)
+				if err != nil {
+					return err
+				}
+
+				periodDuration := time.Duration(period) * time.Second
+				allowance = &types.PeriodicAllowance{
+					Basic:            basic,
+					Period:           periodDuration,
+					PeriodReset:      time.Now().Add(periodDuration),
+					PeriodSpendLimit: periodLimit,
+					PeriodCanSpend:   periodLimit,
+				}
+			}
+
+			msg, err := types.NewMsgGrantAllowance(allowance, granter, grantee)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsGrantAllowance)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRevokeAllowance implements the revoke allowance command.
+func GetCmdRevokeAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance granted to an address",
+		Example: fmt.Sprintf(
+			"%s tx feegrant revoke <grantee> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,35 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/feegrant/keeper"
+	"github.com/irisnet/irishub/modules/feegrant/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize feegrant genesis state: %s", err.Error()))
+	}
+
+	for _, grant := range data.Allowances {
+		k.SetGrant(ctx, grant)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var grants []types.Grant
+	k.IterateAllFeeAllowances(
+		ctx,
+		func(grant types.Grant) bool {
+			grants = append(grants, grant)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(grants)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/feegrant/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Allowance implements the Query/Allowance gRPC method
+func (k Keeper) Allowance(c context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address: %v", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no allowance for granter %s and grantee %s", req.Granter, req.Grantee)
+	}
+
+	return &types.QueryAllowanceResponse{Allowance: &grant}, nil
+}
+
+// Allowances implements the Query/Allowances gRPC method
+func (k Keeper) Allowances(c context.Context, req *types.QueryAllowancesRequest) (*types.QueryAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var grants []*types.Grant
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFeeAllowancePrefixByGrantee(grantee))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+		grants = append(grants, &grant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
+}
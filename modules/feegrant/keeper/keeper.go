@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/feegrant/types"
+)
+
+// Keeper of the feegrant store
+type Keeper struct {
+	cdc           codec.Marshaler
+	storeKey      sdk.StoreKey
+	accountKeeper types.AccountKeeper
+}
+
+// NewKeeper returns a feegrant keeper
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		accountKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GrantAllowance creates a new grant, the grantee account is created if it does not exist
+func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	// create the account if it is not in account state
+	if granteeAcc := k.accountKeeper.GetAccount(ctx, grantee); granteeAcc == nil {
+		granteeAcc = k.accountKeeper.NewAccountWithAddress(ctx, grantee)
+		k.accountKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	grant, err := types.NewGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return err
+	}
+	k.SetGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee),
+		),
+	)
+
+	return nil
+}
+
+// SetGrant stores the grant
+func (k Keeper) SetGrant(ctx sdk.Context, grant types.Grant) {
+	granter, _ := sdk.AccAddressFromBech32(grant.Granter)
+	grantee, _ := sdk.AccAddressFromBech32(grant.Grantee)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	store.Set(types.GetFeeAllowanceKey(granter, grantee), bz)
+}
+
+// RevokeAllowance removes an existing grant
+func (k Keeper) RevokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	if _, found := k.GetGrant(ctx, granter, grantee); !found {
+		return sdkerrors.Wrap(types.ErrNoAllowance, "fee-grant not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeAllowanceKey(granter, grantee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetGrant returns the grant between the granter and the grantee
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// GetAllowance returns the allowance granted to the grantee by the granter
+func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeAllowanceI, error) {
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoAllowance, "fee-grant not found")
+	}
+
+	return grant.GetFeeAllowanceI()
+}
+
+// IterateGranteeFeeAllowances iterates over all the grants of the given grantee
+func (k Keeper) IterateGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress, op func(grant types.Grant) (stop bool)) {
+	k.iterateFeeAllowances(ctx, types.GetFeeAllowancePrefixByGrantee(grantee), op)
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, op func(grant types.Grant) (stop bool)) {
+	k.iterateFeeAllowances(ctx, types.FeeAllowanceKeyPrefix, op)
+}
+
+func (k Keeper) iterateFeeAllowances(ctx sdk.Context, prefix []byte, op func(grant types.Grant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if stop := op(grant); stop {
+			break
+		}
+	}
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "fee-grant not found")
+	}
+
+	allowance, err := grant.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		// ignore the error of revoking, the grant exists
+		_ = k.RevokeAllowance(ctx, granter, grantee)
+		if err != nil {
+			return err
+		}
+		k.emitUseGrantEvent(ctx, granter.String(), grantee.String())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	k.emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+	// if fee allowance is accepted, store the updated state of the allowance
+	updated, err := types.NewGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+	k.SetGrant(ctx, updated)
+
+	return nil
+}
+
+func (k Keeper) emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee),
+		),
+	)
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/feegrant/keeper"
+	"github.com/irisnet/irishub/modules/feegrant/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	grantee = sdk.AccAddress([]byte("grantee_____________"))
+	other   = sdk.AccAddress([]byte("other_______________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	suite.keeper = app.FeeGrantKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestGrantAllowance() {
+	allowance := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))}
+
+	err := suite.keeper.GrantAllowance(suite.ctx, granter, grantee, allowance)
+	suite.NoError(err)
+
+	// the grantee account is created
+	suite.NotNil(suite.app.AccountKeeper.GetAccount(suite.ctx, grantee))
+
+	stored, err := suite.keeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.NoError(err)
+	suite.Equal(allowance, stored)
+
+	_, err = suite.keeper.GetAllowance(suite.ctx, grantee, granter)
+	suite.Error(err)
+
+	var grants []types.Grant
+	suite.keeper.IterateGranteeFeeAllowances(suite.ctx, grantee, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Len(grants, 1)
+	suite.Equal(granter.String(), grants[0].Granter)
+}
+
+func (suite *KeeperTestSuite) TestRevokeAllowance() {
+	allowance := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))}
+	suite.NoError(suite.keeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	suite.Error(suite.keeper.RevokeAllowance(suite.ctx, granter, other))
+	suite.NoError(suite.keeper.RevokeAllowance(suite.ctx, granter, grantee))
+
+	_, found := suite.keeper.GetGrant(suite.ctx, granter, grantee)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFees() {
+	allowance := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))}
+	suite.NoError(suite.keeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	// no allowance granted to other
+	err := suite.keeper.UseGrantedFees(suite.ctx, granter, other, sdk.NewCoins(sdk.NewInt64Coin("uiris", 10)), nil)
+	suite.Error(err)
+
+	// fees beyond the spend limit are rejected
+	err = suite.keeper.UseGrantedFees(suite.ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("uiris", 101)), nil)
+	suite.Error(err)
+
+	err = suite.keeper.UseGrantedFees(suite.ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("uiris", 60)), nil)
+	suite.NoError(err)
+
+	stored, err := suite.keeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("uiris", 40)), stored.(*types.BasicAllowance).SpendLimit)
+
+	// the grant is removed once the spend limit is used up
+	err = suite.keeper.UseGrantedFees(suite.ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("uiris", 40)), nil)
+	suite.NoError(err)
+
+	_, found := suite.keeper.GetGrant(suite.ctx, granter, grantee)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAllowances() {
+	allowance := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))}
+	suite.NoError(suite.keeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+	suite.NoError(suite.keeper.GrantAllowance(suite.ctx, other, grantee, allowance))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.keeper.Allowance(ctx, &types.QueryAllowanceRequest{Granter: granter.String(), Grantee: grantee.String()})
+	suite.NoError(err)
+	suite.Equal(granter.String(), res.Allowance.Granter)
+
+	resAll, err := suite.keeper.Allowances(ctx, &types.QueryAllowancesRequest{Grantee: grantee.String()})
+	suite.NoError(err)
+	suite.Len(resAll.Allowances, 2)
+}
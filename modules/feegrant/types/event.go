@@ -0,0 +1,14 @@
+// nolint
+package types
+
+// feegrant module event types
+const (
+	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeUseFeeGrant    = "use_feegrant"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "feegrant"
+
+	// StoreKey is the default store key for feegrant
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feegrant
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the feegrant store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	FeeAllowanceKeyPrefix = []byte{0x00} // fee allowance key prefix
+)
+
+// GetFeeAllowanceKey returns the key of the fee allowance granted to the grantee by the granter
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// GetFeeAllowancePrefixByGrantee returns the prefix key of all the fee allowances granted to the grantee
+func GetFeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	key := append(FeeAllowanceKeyPrefix, byte(len(grantee)))
+	return append(key, grantee.Bytes()...)
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/feegrant/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestPeriodicAllowance(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	limit := sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))
+	periodLimit := sdk.NewCoins(sdk.NewInt64Coin("uiris", 30))
+	allowance := &types.PeriodicAllowance{
+		Basic:            types.BasicAllowance{SpendLimit: limit},
+		Period:           time.Hour,
+		PeriodReset:      now.Add(time.Hour),
+		PeriodSpendLimit: periodLimit,
+		PeriodCanSpend:   periodLimit,
+	}
+	require.NoError(t, allowance.ValidateBasic())
+
+	remove, err := allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20)), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	// the period limit is exceeded
+	_, err = allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20)), nil)
+	require.Error(t, err)
+
+	// the period limit is reset in the next period
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	remove, err = allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20)), nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 10)), allowance.PeriodCanSpend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 60)), allowance.Basic.SpendLimit)
+
+	// the period limit must be in the denoms of the spend limit
+	allowance.PeriodSpendLimit = sdk.NewCoins(sdk.NewInt64Coin("btc", 20))
+	require.Error(t, allowance.ValidateBasic())
+
+	// the period must be positive
+	allowance.PeriodSpendLimit = periodLimit
+	allowance.Period = 0
+	require.Error(t, allowance.ValidateBasic())
+	allowance.Period = time.Hour
+	require.NoError(t, allowance.ValidateBasic())
+}
+
+func TestBasicAllowanceExpired(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	expiration := now.Add(-time.Hour)
+	allowance := &types.BasicAllowance{Expiration: &expiration}
+
+	remove, err := allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)), nil)
+	require.Error(t, err)
+	require.True(t, remove)
+}
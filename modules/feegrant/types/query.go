@@ -0,0 +1,28 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryAllowanceResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryAllowancesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAllowanceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if res.Allowance == nil {
+		return nil
+	}
+	return res.Allowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAllowancesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range res.Allowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -24,6 +24,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetCmdQuerySupers(),
+		GetCmdQueryParams(),
+		GetCmdQueryMsgMinGasPrices(),
 	)
 	return txCmd
 }
@@ -59,3 +61,57 @@ func GetCmdQuerySupers() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "all supper")
 	return cmd
 }
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current guardian parameters",
+		Example: fmt.Sprintf("%s query guardian params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMsgMinGasPrices implements the query msg min gas prices command.
+func GetCmdQueryMsgMinGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "msg-min-gas-prices",
+		Short:   "Query the minimum gas prices of the message types",
+		Example: fmt.Sprintf("%s query guardian msg-min-gas-prices", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgMinGasPrices(context.Background(), &types.QueryMsgMinGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -18,6 +18,9 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	for _, super := range data.Supers {
 		keeper.AddSuper(ctx, super)
 	}
+	keeper.SetSupersGauge(ctx)
+
+	keeper.SetParamSet(ctx, data.Params)
 }
 
 // ExportGenesis outputs genesis data
@@ -31,7 +34,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(supers)
+	return types.NewGenesisState(supers, k.GetParamSet(ctx))
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
@@ -45,5 +48,5 @@ func ValidateGenesis(data types.GenesisState) error {
 			return err
 		}
 	}
-	return nil
+	return data.Params.Validate()
 }
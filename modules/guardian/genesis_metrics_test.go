@@ -0,0 +1,59 @@
+package guardian_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestSupersMetrics(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "iris", PrometheusRetentionTime: 60})
+	require.NoError(t, err)
+
+	gather := func() string {
+		gr, err := m.Gather(telemetry.FormatPrometheus)
+		require.NoError(t, err)
+		return string(gr.Metrics)
+	}
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	genesisSuper := sdk.AccAddress([]byte("genesis_____________"))
+	supers := []types.Super{types.NewSuper("genesis", types.Genesis, genesisSuper, genesisSuper)}
+	for _, name := range []string{"super1", "super2"} {
+		address := sdk.AccAddress([]byte(name + "______________"))
+		supers = append(supers, types.NewSuper(name, types.Ordinary, address, genesisSuper))
+	}
+	guardian.InitGenesis(ctx, app.GuardianKeeper, *types.NewGenesisState(supers, types.DefaultParams()))
+	require.Contains(t, gather(), "iris_guardian_supers 3")
+
+	handler := guardian.NewHandler(app.GuardianKeeper)
+	added := sdk.AccAddress([]byte("super3______________"))
+
+	// the supers added by the checked and simulated txs are not counted
+	_, err = handler(ctx.WithIsCheckTx(true), types.NewMsgAddSuper("super3", added, genesisSuper))
+	require.NoError(t, err)
+	require.Contains(t, gather(), "iris_guardian_supers 3")
+	require.NotContains(t, gather(), "iris_guardian_super_added")
+
+	app.GuardianKeeper.DeleteSuper(ctx, added)
+	_, err = handler(ctx, types.NewMsgAddSuper("super3", added, genesisSuper))
+	require.NoError(t, err)
+	require.Contains(t, gather(), "iris_guardian_supers 4")
+	require.Contains(t, gather(), "iris_guardian_super_added 1")
+
+	_, err = handler(ctx, types.NewMsgDeleteSuper(added, genesisSuper))
+	require.NoError(t, err)
+	require.Contains(t, gather(), "iris_guardian_supers 3")
+	require.Contains(t, gather(), "iris_guardian_super_deleted 1")
+}
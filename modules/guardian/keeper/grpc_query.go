@@ -35,3 +35,19 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 
 	return &types.QuerySupersResponse{Supers: supers, Pagination: pageRes}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// MsgMinGasPrices implements the Query/MsgMinGasPrices gRPC method
+func (k Keeper) MsgMinGasPrices(c context.Context, _ *types.QueryMsgMinGasPricesRequest) (*types.QueryMsgMinGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryMsgMinGasPricesResponse{MsgMinGasPrices: params.MsgMinGasPrices}, nil
+}
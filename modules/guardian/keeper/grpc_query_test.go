@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
@@ -28,3 +29,33 @@ func (suite *KeeperTestSuite) TestGRPCQuerySupers() {
 	suite.Len(supersResp.Supers, 1)
 	suite.Equal(guardian, supersResp.Supers[0])
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryParams() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	paramsResp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultParams(), paramsResp.Params)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMsgMinGasPrices() {
+	app, ctx := suite.app, suite.ctx
+
+	params := types.DefaultParams()
+	params.MsgMinGasPrices = []types.MsgMinGasPrice{
+		types.NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)))),
+	}
+	app.GuardianKeeper.SetParamSet(ctx, params)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	pricesResp, err := queryClient.MsgMinGasPrices(gocontext.Background(), &types.QueryMsgMinGasPricesRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(params.MsgMinGasPrices, pricesResp.MsgMinGasPrices)
+}
@@ -6,22 +6,26 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // Keeper of the guardian store
 type Keeper struct {
-	cdc      codec.Marshaler
-	storeKey sdk.StoreKey
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 }
 
 // NewKeeper returns a guardian keeper
-func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
 	keeper := Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 	}
 	return keeper
 }
@@ -45,6 +49,17 @@ func (k Keeper) DeleteSuper(ctx sdk.Context, address sdk.AccAddress) {
 	store.Delete(types.GetSuperKey(address))
 }
 
+// SetSupersGauge sets the gauge of the number of the supers. It is called when the supers
+// are initialized or changed, rather than every block
+func (k Keeper) SetSupersGauge(ctx sdk.Context) {
+	supers := 0
+	k.IterateSupers(ctx, func(types.Super) bool {
+		supers++
+		return false
+	})
+	telemetry.SetGauge(float32(supers), types.ModuleName, "supers")
+}
+
 // GetSuper retrieves the super by specified address
 func (k Keeper) GetSuper(ctx sdk.Context, addr sdk.AccAddress) (super types.Super, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -79,3 +94,15 @@ func (k Keeper) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
 	_, found := k.GetSuper(ctx, addr)
 	return found
 }
+
+// GetParamSet returns the guardian params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets the guardian params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// MigrateParams sets the params added to the guardian module to their default values,
+// the params already set are kept
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyMaxRepeatedTotal, params.MaxRepeatedTotal},
+		{types.KeyMinRepeatedFrequency, params.MinRepeatedFrequency},
+		{types.KeyMaxRepeatedFeeCap, params.MaxRepeatedFeeCap},
+		{types.KeyFeeDenoms, params.FeeDenoms},
+		{types.KeyMaxFeeSlippage, params.MaxFeeSlippage},
+		{types.KeyMinFeePoolLiquidity, params.MinFeePoolLiquidity},
+		{types.KeyMsgMinGasPrices, params.MsgMinGasPrices},
+	} {
+		if !k.paramSpace.Has(ctx, pair.key) {
+			k.paramSpace.Set(ctx, pair.key, pair.value)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	params := types.DefaultParams()
+	params.MaxRepeatedTotal = 5
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	// restore the state of a chain started before the params were added
+	paramStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyMinRepeatedFrequency, types.KeyMaxRepeatedFeeCap,
+		types.KeyFeeDenoms, types.KeyMaxFeeSlippage, types.KeyMinFeePoolLiquidity,
+		types.KeyMsgMinGasPrices,
+	} {
+		paramStore.Delete(key)
+	}
+	suite.Panics(func() { suite.keeper.GetParamSet(suite.ctx) })
+
+	suite.keeper.MigrateParams(suite.ctx)
+	suite.Equal(params, suite.keeper.GetParamSet(suite.ctx))
+}
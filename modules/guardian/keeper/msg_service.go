@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -39,6 +40,11 @@ func (m msgServer) AddSuper(goCtx context.Context, msg *types.MsgAddSuper) (*typ
 	}
 	super := types.NewSuper(msg.Description, types.Ordinary, address, addedBy)
 	m.Keeper.AddSuper(ctx, super)
+	// the simulated txs are run on the check state, so that they are never counted
+	if !ctx.IsCheckTx() {
+		telemetry.IncrCounter(1, types.ModuleName, "super", "added")
+		m.Keeper.SetSupersGauge(ctx)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -80,6 +86,10 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 	}
 
 	m.Keeper.DeleteSuper(ctx, address)
+	if !ctx.IsCheckTx() {
+		telemetry.IncrCounter(1, types.ModuleName, "super", "deleted")
+		m.Keeper.SetSupersGauge(ctx)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
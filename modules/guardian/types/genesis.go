@@ -1,13 +1,16 @@
 package types
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(supers []Super) *GenesisState {
+func NewGenesisState(supers []Super, params Params) *GenesisState {
 	return &GenesisState{
 		Supers: supers,
+		Params: params,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
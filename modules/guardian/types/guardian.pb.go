@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -120,38 +122,295 @@ func (m *Super) GetAddedBy() string {
 	return ""
 }
 
+// Params defines the parameters managed by the guardian module
+type Params struct {
+	// max_repeated_total defines the maximum number of batches of a repeated service invocation
+	MaxRepeatedTotal uint64 `protobuf:"varint,1,opt,name=max_repeated_total,json=maxRepeatedTotal,proto3" json:"max_repeated_total,omitempty" yaml:"max_repeated_total"`
+	// min_repeated_frequency defines the minimum number of blocks between two batches of a repeated service invocation
+	MinRepeatedFrequency uint64 `protobuf:"varint,2,opt,name=min_repeated_frequency,json=minRepeatedFrequency,proto3" json:"min_repeated_frequency,omitempty" yaml:"min_repeated_frequency"`
+	// max_repeated_fee_cap defines the maximum service fee escrowed by a repeated service invocation
+	MaxRepeatedFeeCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_repeated_fee_cap,json=maxRepeatedFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_repeated_fee_cap" yaml:"max_repeated_fee_cap"`
+	// fee_denoms defines the denoms which can be used to pay fees by swapping them into the standard denom
+	FeeDenoms []string `protobuf:"bytes,4,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty" yaml:"fee_denoms"`
+	// max_fee_slippage defines the maximum slippage allowed when swapping fees into the standard denom
+	MaxFeeSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_fee_slippage,json=maxFeeSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee_slippage" yaml:"max_fee_slippage"`
+	// min_fee_pool_liquidity defines the minimum standard denom reserve of a pool used to swap fees
+	MinFeePoolLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_fee_pool_liquidity,json=minFeePoolLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_pool_liquidity" yaml:"min_fee_pool_liquidity"`
+	// msg_min_gas_prices defines the minimum gas prices of the txs containing the given message types
+	MsgMinGasPrices []MsgMinGasPrice `protobuf:"bytes,7,rep,name=msg_min_gas_prices,json=msgMinGasPrices,proto3" json:"msg_min_gas_prices" yaml:"msg_min_gas_prices"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxRepeatedTotal() uint64 {
+	if m != nil {
+		return m.MaxRepeatedTotal
+	}
+	return 0
+}
+
+func (m *Params) GetMinRepeatedFrequency() uint64 {
+	if m != nil {
+		return m.MinRepeatedFrequency
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRepeatedFeeCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxRepeatedFeeCap
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenoms() []string {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMsgMinGasPrices() []MsgMinGasPrice {
+	if m != nil {
+		return m.MsgMinGasPrices
+	}
+	return nil
+}
+
+// MsgMinGasPrice defines the minimum gas prices of a message type
+type MsgMinGasPrice struct {
+	MsgTypeUrl   string                                      `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+}
+
+func (m *MsgMinGasPrice) Reset()         { *m = MsgMinGasPrice{} }
+func (m *MsgMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgMinGasPrice) ProtoMessage()    {}
+func (*MsgMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{2}
+}
+func (m *MsgMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMinGasPrice.Merge(m, src)
+}
+func (m *MsgMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMinGasPrice proto.InternalMessageInfo
+
+func (m *MsgMinGasPrice) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgMinGasPrice) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
 	proto.RegisterType((*Super)(nil), "irishub.guardian.Super")
+	proto.RegisterType((*Params)(nil), "irishub.guardian.Params")
+	proto.RegisterType((*MsgMinGasPrice)(nil), "irishub.guardian.MsgMinGasPrice")
 }
 
 func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
 
 var fileDescriptor_07c8fad859e95e75 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x87, 0x31, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x32,
-	0x8b, 0x32, 0x8b, 0x33, 0x4a, 0x93, 0xf4, 0x60, 0xe2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x49, 0x7d, 0x10, 0x0b, 0xa2, 0x4e, 0x69, 0x27, 0x23, 0x17, 0x6b, 0x70, 0x69, 0x41, 0x6a, 0x91,
-	0x90, 0x02, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0xb2, 0x90, 0x50, 0x24, 0x17, 0x4f, 0x62, 0x72, 0x72, 0x7e,
-	0x69, 0x5e, 0x49, 0x7c, 0x49, 0x65, 0x41, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0xac,
-	0x1e, 0xba, 0x55, 0x7a, 0x8e, 0x10, 0x55, 0x21, 0x95, 0x05, 0xa9, 0x4e, 0xe2, 0x9f, 0xee, 0xc9,
-	0x0b, 0x57, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x21, 0x6b, 0x56, 0x0a, 0xe2, 0x4e, 0x44, 0xa8, 0x12,
-	0x92, 0xe0, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x06, 0x5b, 0x0c, 0xe3,
-	0x0a, 0x49, 0x72, 0x71, 0x24, 0xa6, 0xa4, 0xa4, 0xa6, 0xc4, 0x27, 0x55, 0x4a, 0xb0, 0xc0, 0xa5,
-	0x52, 0x53, 0x9c, 0x2a, 0xb5, 0x3c, 0xb9, 0xb8, 0x1d, 0x51, 0xcd, 0x70, 0x77, 0xf5, 0x73, 0x0d,
-	0xf6, 0x0c, 0x16, 0x60, 0x90, 0xe2, 0xee, 0x9a, 0xab, 0xc0, 0xee, 0x9e, 0x9a, 0x97, 0x5a, 0x9c,
-	0x59, 0x2c, 0x24, 0xc5, 0xc5, 0xe1, 0x1f, 0xe4, 0xe2, 0xe9, 0xe7, 0x18, 0x14, 0x29, 0xc0, 0x28,
-	0xc5, 0xd3, 0x35, 0x57, 0x81, 0xc3, 0xbf, 0x28, 0x25, 0x33, 0x2f, 0xb1, 0xa8, 0x52, 0x8a, 0xa5,
-	0x63, 0xb1, 0x1c, 0x83, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x19, 0xa6, 0x67, 0x96, 0x80, 0x3c, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf2, 0x68, 0x5e, 0x6a, 0x89,
-	0x3e, 0xd4, 0xc3, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xc5, 0xf0, 0xb0, 0xd7, 0x07, 0xf9,
-	0xac, 0x38, 0x89, 0x0d, 0x1c, 0xb4, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x96, 0xfb,
-	0xdc, 0x9d, 0x01, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xdc, 0x36,
+	0x18, 0x3d, 0xc5, 0xe7, 0x5f, 0x3c, 0xc3, 0x75, 0x18, 0xa7, 0x96, 0xaf, 0xb5, 0xa4, 0x68, 0x28,
+	0x0e, 0x2d, 0x2a, 0xc1, 0x69, 0x97, 0x66, 0xb3, 0xe2, 0xd8, 0x38, 0xe4, 0x87, 0x0d, 0x39, 0x45,
+	0x91, 0x2e, 0x02, 0x4f, 0xfa, 0xac, 0x12, 0x15, 0x45, 0x45, 0x94, 0x0a, 0x6b, 0xef, 0x50, 0x64,
+	0xca, 0x58, 0xa0, 0x08, 0x10, 0xa0, 0x5b, 0xfe, 0x83, 0xfe, 0x07, 0x19, 0x33, 0x16, 0x1d, 0x94,
+	0xc2, 0xee, 0xd0, 0xf9, 0xc6, 0x4e, 0x05, 0x25, 0x5d, 0x4e, 0x97, 0xf3, 0xe0, 0x4c, 0xa2, 0x3e,
+	0x3e, 0x3e, 0xbe, 0xc7, 0xf7, 0x91, 0x68, 0x2b, 0xcc, 0x49, 0x1a, 0x50, 0x12, 0xdb, 0x93, 0x81,
+	0x95, 0xa4, 0x3c, 0xe3, 0x78, 0x83, 0xa6, 0x54, 0xfc, 0x90, 0x8f, 0xac, 0x49, 0xbd, 0xbf, 0x19,
+	0xf2, 0x90, 0x57, 0x93, 0xb6, 0x1c, 0xd5, 0xb8, 0xbe, 0xe6, 0x73, 0xc1, 0xb8, 0xb0, 0x47, 0x44,
+	0x80, 0xfd, 0xd3, 0xee, 0x08, 0x32, 0xb2, 0x6b, 0xfb, 0x9c, 0x36, 0x3c, 0xe6, 0x1f, 0x0a, 0x5a,
+	0x3c, 0xc9, 0x13, 0x48, 0xb1, 0x81, 0x7a, 0x01, 0x08, 0x3f, 0xa5, 0x49, 0x46, 0x79, 0xac, 0x2a,
+	0x86, 0x32, 0x58, 0x75, 0xdb, 0x25, 0xfc, 0x04, 0xad, 0x11, 0xdf, 0xe7, 0x79, 0x9c, 0x79, 0x59,
+	0x91, 0x80, 0x7a, 0xcd, 0x50, 0x06, 0xeb, 0xb7, 0x77, 0xac, 0xf7, 0xa5, 0x58, 0x7b, 0x35, 0xea,
+	0x71, 0x91, 0x80, 0xb3, 0x35, 0x2e, 0xf5, 0x1b, 0x05, 0x61, 0xd1, 0x1d, 0xb3, 0xbd, 0xd8, 0x74,
+	0x7b, 0x64, 0x8a, 0xc2, 0x2a, 0x5a, 0x26, 0x41, 0x90, 0x82, 0x10, 0xea, 0x42, 0xb5, 0xf1, 0xe4,
+	0x17, 0x6f, 0xa3, 0x15, 0x12, 0x04, 0x10, 0x78, 0xa3, 0x42, 0xed, 0xbe, 0x9b, 0x82, 0xc0, 0x29,
+	0xcc, 0xff, 0x16, 0xd1, 0xd2, 0x31, 0x49, 0x09, 0x13, 0xf8, 0x3e, 0xc2, 0x8c, 0x9c, 0x79, 0x29,
+	0x24, 0x40, 0x32, 0x08, 0xbc, 0x8c, 0x67, 0x24, 0xaa, 0x3c, 0x74, 0x9d, 0x9d, 0x71, 0xa9, 0x6f,
+	0xd7, 0x0a, 0xe6, 0x31, 0xa6, 0xbb, 0xc1, 0xc8, 0x99, 0xdb, 0xd4, 0x1e, 0xcb, 0x12, 0xfe, 0x0e,
+	0x7d, 0xcc, 0x68, 0x3c, 0x05, 0x9e, 0xa6, 0xf0, 0x34, 0x87, 0xd8, 0x2f, 0x2a, 0xc7, 0x5d, 0xe7,
+	0xd6, 0xb8, 0xd4, 0x77, 0x1a, 0xc2, 0x4b, 0x71, 0xa6, 0xbb, 0xc9, 0x68, 0x3c, 0x21, 0x3d, 0x98,
+	0x94, 0xf1, 0x6f, 0x0a, 0xda, 0x9c, 0x91, 0x70, 0x0a, 0xe0, 0xf9, 0x24, 0x51, 0x17, 0x8c, 0x85,
+	0x41, 0xef, 0xf6, 0xb6, 0x55, 0x87, 0x65, 0xc9, 0xb0, 0xac, 0x26, 0x2c, 0xeb, 0x2e, 0xa7, 0xb1,
+	0x73, 0xf4, 0xba, 0xd4, 0x3b, 0xe3, 0x52, 0xff, 0xe4, 0x12, 0x1f, 0x0d, 0x89, 0xf9, 0xea, 0xad,
+	0x3e, 0x08, 0x69, 0x26, 0x93, 0xf0, 0x39, 0xb3, 0x9b, 0xe0, 0xeb, 0xcf, 0x97, 0x22, 0xf8, 0xd1,
+	0x96, 0xe7, 0x2e, 0x2a, 0x3e, 0xe1, 0x5e, 0x6f, 0xb9, 0x3e, 0x00, 0xb8, 0x4b, 0x12, 0xfc, 0x35,
+	0x42, 0x92, 0x2a, 0x80, 0x98, 0x33, 0xa1, 0x76, 0x8d, 0x85, 0xc1, 0xaa, 0x73, 0x73, 0x5c, 0xea,
+	0xd7, 0xeb, 0x3d, 0xa7, 0x73, 0xa6, 0xbb, 0x7a, 0x0a, 0xb0, 0x5f, 0x8d, 0xb1, 0x40, 0xf2, 0x00,
+	0x2b, 0x11, 0x22, 0xa2, 0x49, 0x42, 0x42, 0x50, 0x17, 0x65, 0x4e, 0xce, 0x50, 0x6a, 0xfe, 0xab,
+	0xd4, 0x3f, 0xbb, 0x82, 0xa8, 0x7d, 0xf0, 0xc7, 0xa5, 0xbe, 0x35, 0x75, 0xd7, 0xe6, 0x33, 0xdd,
+	0x75, 0x46, 0xce, 0x0e, 0x00, 0x4e, 0x9a, 0x02, 0xfe, 0x59, 0xa9, 0x23, 0x92, 0xa8, 0x84, 0xf3,
+	0xc8, 0x8b, 0xe8, 0xd3, 0x9c, 0x06, 0x34, 0x2b, 0xd4, 0xa5, 0x6a, 0xef, 0xa3, 0x0f, 0xd8, 0x7b,
+	0x18, 0x67, 0xb3, 0x81, 0xce, 0xb3, 0x9a, 0xee, 0x0d, 0x46, 0xe3, 0x03, 0x80, 0x63, 0xce, 0xa3,
+	0x07, 0x93, 0x2a, 0x4e, 0x10, 0x66, 0x22, 0xf4, 0xe4, 0x9a, 0x90, 0x08, 0x2f, 0x49, 0xa9, 0x0f,
+	0x42, 0x5d, 0xae, 0xc2, 0x34, 0xe6, 0xaf, 0xc5, 0x43, 0x11, 0x3e, 0xa4, 0xf1, 0x21, 0x11, 0xc7,
+	0x12, 0xe8, 0xdc, 0x6a, 0x32, 0x9d, 0xf4, 0xe6, 0x1c, 0x93, 0xe9, 0x7e, 0xc4, 0x66, 0x96, 0x88,
+	0x3b, 0x2b, 0xbf, 0xbe, 0xd4, 0x3b, 0xff, 0xbe, 0xd4, 0x15, 0xf3, 0x1f, 0x05, 0xad, 0xcf, 0x12,
+	0xe2, 0x6f, 0xd0, 0x9a, 0x24, 0x91, 0xae, 0xbc, 0x3c, 0xad, 0xdb, 0x7f, 0xb5, 0x7d, 0x01, 0xdb,
+	0xb3, 0xa6, 0x8b, 0x98, 0x08, 0xe5, 0xe5, 0xfb, 0x36, 0x8d, 0xf0, 0x73, 0x05, 0xad, 0xbf, 0x67,
+	0xe3, 0x5a, 0x65, 0xe3, 0xd3, 0x4b, 0x7b, 0x72, 0x1f, 0xfc, 0xaa, 0x2d, 0x1f, 0x34, 0x16, 0x6e,
+	0x4e, 0x0f, 0xaf, 0x25, 0xff, 0xd5, 0x5b, 0xfd, 0x8b, 0xab, 0x65, 0x5f, 0xf7, 0xe4, 0x1a, 0x6b,
+	0x5b, 0xed, 0x4a, 0x9b, 0x9f, 0x0f, 0x51, 0x6f, 0x6f, 0xf6, 0x9d, 0x38, 0xbc, 0xf7, 0xe8, 0xde,
+	0xc9, 0xf0, 0x64, 0xa3, 0xd3, 0xef, 0x3d, 0x7b, 0x61, 0x2c, 0x1f, 0x42, 0x0c, 0x82, 0x0a, 0xdc,
+	0x47, 0x2b, 0x47, 0xee, 0xfe, 0xf0, 0xd1, 0x9e, 0xfb, 0x64, 0x43, 0xe9, 0xaf, 0x3d, 0x7b, 0x61,
+	0xac, 0x1c, 0xa5, 0x01, 0x8d, 0x49, 0x5a, 0xf4, 0xbb, 0xbf, 0xfc, 0xae, 0x75, 0x9c, 0xfb, 0xaf,
+	0xcf, 0x35, 0xe5, 0xcd, 0xb9, 0xa6, 0xfc, 0x7d, 0xae, 0x29, 0xcf, 0x2f, 0xb4, 0xce, 0x9b, 0x0b,
+	0xad, 0xf3, 0xe7, 0x85, 0xd6, 0xf9, 0x7e, 0xb7, 0xa5, 0x52, 0xa6, 0x16, 0x43, 0x66, 0x37, 0xe9,
+	0xd9, 0x8c, 0x07, 0x79, 0x04, 0xe2, 0xdd, 0xfb, 0x5b, 0x8b, 0x1e, 0x2d, 0x55, 0xcf, 0xe7, 0x57,
+	0xff, 0x0f, 0x00, 0x24, 0xa7, 0xa3, 0x52, 0xa1, 0x05, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxRepeatedTotal != that1.MaxRepeatedTotal {
+		return false
+	}
+	if this.MinRepeatedFrequency != that1.MinRepeatedFrequency {
+		return false
+	}
+	if len(this.MaxRepeatedFeeCap) != len(that1.MaxRepeatedFeeCap) {
+		return false
+	}
+	for i := range this.MaxRepeatedFeeCap {
+		if !this.MaxRepeatedFeeCap[i].Equal(&that1.MaxRepeatedFeeCap[i]) {
+			return false
+		}
+	}
+	if len(this.FeeDenoms) != len(that1.FeeDenoms) {
+		return false
+	}
+	for i := range this.FeeDenoms {
+		if this.FeeDenoms[i] != that1.FeeDenoms[i] {
+			return false
+		}
+	}
+	if !this.MaxFeeSlippage.Equal(that1.MaxFeeSlippage) {
+		return false
+	}
+	if !this.MinFeePoolLiquidity.Equal(that1.MinFeePoolLiquidity) {
+		return false
+	}
+	if len(this.MsgMinGasPrices) != len(that1.MsgMinGasPrices) {
+		return false
+	}
+	for i := range this.MsgMinGasPrices {
+		if !this.MsgMinGasPrices[i].Equal(&that1.MsgMinGasPrices[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgMinGasPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*MsgMinGasPrice)
+	if !ok {
+		that2, ok := that.(MsgMinGasPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Super) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -201,6 +460,140 @@ func (m *Super) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgMinGasPrices) > 0 {
+		for iNdEx := len(m.MsgMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuardian(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.MinFeePoolLiquidity.Size()
+		i -= size
+		if _, err := m.MinFeePoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxFeeSlippage.Size()
+		i -= size
+		if _, err := m.MaxFeeSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeDenoms[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.FeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MaxRepeatedFeeCap) > 0 {
+		for iNdEx := len(m.MaxRepeatedFeeCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxRepeatedFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuardian(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MinRepeatedFrequency != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.MinRepeatedFrequency))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRepeatedTotal != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.MaxRepeatedTotal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuardian(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGuardian(v)
 	base := offset
@@ -236,6 +629,62 @@ func (m *Super) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRepeatedTotal != 0 {
+		n += 1 + sovGuardian(uint64(m.MaxRepeatedTotal))
+	}
+	if m.MinRepeatedFrequency != 0 {
+		n += 1 + sovGuardian(uint64(m.MinRepeatedFrequency))
+	}
+	if len(m.MaxRepeatedFeeCap) > 0 {
+		for _, e := range m.MaxRepeatedFeeCap {
+			l = e.Size()
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, s := range m.FeeDenoms {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	l = m.MaxFeeSlippage.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	l = m.MinFeePoolLiquidity.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	if len(m.MsgMinGasPrices) > 0 {
+		for _, e := range m.MsgMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGuardian(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -407,6 +856,378 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRepeatedTotal", wireType)
+			}
+			m.MaxRepeatedTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRepeatedTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRepeatedFrequency", wireType)
+			}
+			m.MinRepeatedFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRepeatedFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRepeatedFeeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRepeatedFeeCap = append(m.MaxRepeatedFeeCap, types.Coin{})
+			if err := m.MaxRepeatedFeeCap[len(m.MaxRepeatedFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgMinGasPrices = append(m.MsgMinGasPrices, MsgMinGasPrice{})
+			if err := m.MsgMinGasPrices[len(m.MsgMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGuardian(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
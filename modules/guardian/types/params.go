@@ -0,0 +1,251 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyMaxRepeatedTotal     = []byte("MaxRepeatedTotal")
+	KeyMinRepeatedFrequency = []byte("MinRepeatedFrequency")
+	KeyMaxRepeatedFeeCap    = []byte("MaxRepeatedFeeCap")
+	KeyFeeDenoms            = []byte("FeeDenoms")
+	KeyMaxFeeSlippage       = []byte("MaxFeeSlippage")
+	KeyMinFeePoolLiquidity  = []byte("MinFeePoolLiquidity")
+	KeyMsgMinGasPrices      = []byte("MsgMinGasPrices")
+)
+
+// ParamKeyTable for guardian module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxRepeatedTotal, minRepeatedFrequency uint64,
+	maxRepeatedFeeCap sdk.Coins,
+	feeDenoms []string,
+	maxFeeSlippage sdk.Dec,
+	minFeePoolLiquidity sdk.Int,
+	msgMinGasPrices []MsgMinGasPrice,
+) Params {
+	return Params{
+		MaxRepeatedTotal:     maxRepeatedTotal,
+		MinRepeatedFrequency: minRepeatedFrequency,
+		MaxRepeatedFeeCap:    maxRepeatedFeeCap,
+		FeeDenoms:            feeDenoms,
+		MaxFeeSlippage:       maxFeeSlippage,
+		MinFeePoolLiquidity:  minFeePoolLiquidity,
+		MsgMinGasPrices:      msgMinGasPrices,
+	}
+}
+
+// NewMsgMinGasPrice creates a new MsgMinGasPrice instance
+func NewMsgMinGasPrice(msgTypeURL string, minGasPrices sdk.DecCoins) MsgMinGasPrice {
+	return MsgMinGasPrice{
+		MsgTypeUrl:   msgTypeURL,
+		MinGasPrices: minGasPrices,
+	}
+}
+
+// DefaultParams returns default guardian module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxRepeatedTotal:     100,
+		MinRepeatedFrequency: 20,
+		MaxRepeatedFeeCap: sdk.NewCoins(
+			sdk.NewCoin(tokentypes.GetNativeToken().MinUnit, sdk.NewIntWithDecimal(10000, 6)), // 10000iris
+		),
+		MaxFeeSlippage:      sdk.NewDecWithPrec(5, 2),
+		MinFeePoolLiquidity: sdk.NewIntWithDecimal(1000, 6), // 1000iris
+	}
+}
+
+// IsFeeDenom returns true if the given denom can be used to pay fees by swapping it into the standard denom
+func (p Params) IsFeeDenom(denom string) bool {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// MinGasPricesOf returns the minimum gas prices of the given message type,
+// false is returned if the message type is not in the table
+func (p Params) MinGasPricesOf(msgTypeURL string) (sdk.DecCoins, bool) {
+	for _, price := range p.MsgMinGasPrices {
+		if price.MsgTypeUrl == msgTypeURL {
+			return price.MinGasPrices, true
+		}
+	}
+	return nil, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxRepeatedTotal, &p.MaxRepeatedTotal, validateMaxRepeatedTotal),
+		paramtypes.NewParamSetPair(KeyMinRepeatedFrequency, &p.MinRepeatedFrequency, validateMinRepeatedFrequency),
+		paramtypes.NewParamSetPair(KeyMaxRepeatedFeeCap, &p.MaxRepeatedFeeCap, validateMaxRepeatedFeeCap),
+		paramtypes.NewParamSetPair(KeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		paramtypes.NewParamSetPair(KeyMaxFeeSlippage, &p.MaxFeeSlippage, validateMaxFeeSlippage),
+		paramtypes.NewParamSetPair(KeyMinFeePoolLiquidity, &p.MinFeePoolLiquidity, validateMinFeePoolLiquidity),
+		paramtypes.NewParamSetPair(KeyMsgMinGasPrices, &p.MsgMinGasPrices, validateMsgMinGasPrices),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateMaxRepeatedTotal(p.MaxRepeatedTotal); err != nil {
+		return err
+	}
+	if err := validateMinRepeatedFrequency(p.MinRepeatedFrequency); err != nil {
+		return err
+	}
+	if err := validateMaxRepeatedFeeCap(p.MaxRepeatedFeeCap); err != nil {
+		return err
+	}
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+	if err := validateMaxFeeSlippage(p.MaxFeeSlippage); err != nil {
+		return err
+	}
+	if err := validateMinFeePoolLiquidity(p.MinFeePoolLiquidity); err != nil {
+		return err
+	}
+	return validateMsgMinGasPrices(p.MsgMinGasPrices)
+}
+
+func validateMaxRepeatedTotal(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max repeated total must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinRepeatedFrequency(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("min repeated frequency must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxRepeatedFeeCap(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid max repeated fee cap: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if strings.HasPrefix(denom, coinswaptypes.FormatUniABSPrefix) {
+			return fmt.Errorf("liquidity token can not be used to pay fees: %s", denom)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate fee denom: %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+func validateMaxFeeSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max fee slippage must be in [0, 1): %s", v)
+	}
+
+	return nil
+}
+
+func validateMinFeePoolLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min fee pool liquidity must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMsgMinGasPrices(i interface{}) error {
+	v, ok := i.([]MsgMinGasPrice)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenMsgTypes := make(map[string]bool)
+	for _, price := range v {
+		if !strings.HasPrefix(price.MsgTypeUrl, "/") || len(strings.TrimSpace(price.MsgTypeUrl)) <= 1 {
+			return fmt.Errorf("invalid msg type url: %s", price.MsgTypeUrl)
+		}
+		if seenMsgTypes[price.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg type url: %s", price.MsgTypeUrl)
+		}
+		seenMsgTypes[price.MsgTypeUrl] = true
+
+		if err := price.MinGasPrices.Validate(); err != nil {
+			return fmt.Errorf("invalid min gas prices of %s: %s", price.MsgTypeUrl, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	newParams := func(maxRepeatedTotal, minRepeatedFrequency uint64, maxRepeatedFeeCap sdk.Coins) Params {
+		return NewParams(
+			maxRepeatedTotal, minRepeatedFrequency, maxRepeatedFeeCap,
+			[]string{"btc"}, sdk.NewDecWithPrec(5, 2), sdk.NewInt(1000), nil,
+		)
+	}
+
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{"default", DefaultParams(), false},
+		{"zero max repeated total", newParams(0, 20, sdk.NewCoins()), true},
+		{"zero min repeated frequency", newParams(100, 0, sdk.NewCoins()), true},
+		{"empty max repeated fee cap", newParams(100, 20, sdk.NewCoins()), false},
+		{"invalid max repeated fee cap", newParams(100, 20, sdk.Coins{sdk.Coin{Denom: "iris", Amount: sdk.NewInt(-1)}}), true},
+		{"invalid fee denom", NewParams(100, 20, sdk.NewCoins(), []string{"b"}, sdk.ZeroDec(), sdk.ZeroInt(), nil), true},
+		{"liquidity token fee denom", NewParams(100, 20, sdk.NewCoins(), []string{"swap/btc"}, sdk.ZeroDec(), sdk.ZeroInt(), nil), true},
+		{"duplicate fee denom", NewParams(100, 20, sdk.NewCoins(), []string{"btc", "btc"}, sdk.ZeroDec(), sdk.ZeroInt(), nil), true},
+		{"max fee slippage too large", NewParams(100, 20, sdk.NewCoins(), []string{"btc"}, sdk.OneDec(), sdk.ZeroInt(), nil), true},
+		{"negative min fee pool liquidity", NewParams(100, 20, sdk.NewCoins(), []string{"btc"}, sdk.ZeroDec(), sdk.NewInt(-1), nil), true},
+		{"msg min gas prices", NewParams(100, 20, sdk.NewCoins(), nil, sdk.ZeroDec(), sdk.ZeroInt(), []MsgMinGasPrice{
+			NewMsgMinGasPrice("/irismod.oracle.MsgCreateFeed", nil),
+			NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)))),
+		}), false},
+		{"invalid msg type url", NewParams(100, 20, sdk.NewCoins(), nil, sdk.ZeroDec(), sdk.ZeroInt(), []MsgMinGasPrice{
+			NewMsgMinGasPrice("cosmos.bank.v1beta1.MsgSend", nil),
+		}), true},
+		{"duplicate msg type url", NewParams(100, 20, sdk.NewCoins(), nil, sdk.ZeroDec(), sdk.ZeroInt(), []MsgMinGasPrice{
+			NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", nil),
+			NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", nil),
+		}), true},
+		{"invalid msg min gas prices", NewParams(100, 20, sdk.NewCoins(), nil, sdk.ZeroDec(), sdk.ZeroInt(), []MsgMinGasPrice{
+			NewMsgMinGasPrice("/cosmos.bank.v1beta1.MsgSend", sdk.DecCoins{sdk.DecCoin{Denom: "uiris", Amount: sdk.NewDec(-1)}}),
+		}), true},
+	}
+
+	for _, tt := range tests {
+		err := tt.params.Validate()
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
@@ -129,38 +129,214 @@ func (m *QuerySupersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{2}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{3}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryMsgMinGasPricesRequest is request type for the Query/MsgMinGasPrices RPC method
+type QueryMsgMinGasPricesRequest struct {
+}
+
+func (m *QueryMsgMinGasPricesRequest) Reset()         { *m = QueryMsgMinGasPricesRequest{} }
+func (m *QueryMsgMinGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgMinGasPricesRequest) ProtoMessage()    {}
+func (*QueryMsgMinGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{4}
+}
+func (m *QueryMsgMinGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgMinGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgMinGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgMinGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgMinGasPricesRequest.Merge(m, src)
+}
+func (m *QueryMsgMinGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgMinGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgMinGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgMinGasPricesRequest proto.InternalMessageInfo
+
+// QueryMsgMinGasPricesResponse is response type for the Query/MsgMinGasPrices RPC method
+type QueryMsgMinGasPricesResponse struct {
+	MsgMinGasPrices []MsgMinGasPrice `protobuf:"bytes,1,rep,name=msg_min_gas_prices,json=msgMinGasPrices,proto3" json:"msg_min_gas_prices"`
+}
+
+func (m *QueryMsgMinGasPricesResponse) Reset()         { *m = QueryMsgMinGasPricesResponse{} }
+func (m *QueryMsgMinGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgMinGasPricesResponse) ProtoMessage()    {}
+func (*QueryMsgMinGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{5}
+}
+func (m *QueryMsgMinGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgMinGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgMinGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgMinGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgMinGasPricesResponse.Merge(m, src)
+}
+func (m *QueryMsgMinGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgMinGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgMinGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgMinGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgMinGasPricesResponse) GetMsgMinGasPrices() []MsgMinGasPrice {
+	if m != nil {
+		return m.MsgMinGasPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySupersRequest)(nil), "irishub.guardian.QuerySupersRequest")
 	proto.RegisterType((*QuerySupersResponse)(nil), "irishub.guardian.QuerySupersResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.guardian.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.guardian.QueryParamsResponse")
+	proto.RegisterType((*QueryMsgMinGasPricesRequest)(nil), "irishub.guardian.QueryMsgMinGasPricesRequest")
+	proto.RegisterType((*QueryMsgMinGasPricesResponse)(nil), "irishub.guardian.QueryMsgMinGasPricesResponse")
 }
 
 func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
 
 var fileDescriptor_20cf24f8e5be2110 = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcb, 0x4e, 0x32, 0x31,
-	0x14, 0xc7, 0xa7, 0x7c, 0x9f, 0x2c, 0xca, 0xc6, 0x54, 0x12, 0xc8, 0xc4, 0x8c, 0x84, 0x78, 0x21,
-	0x2e, 0xda, 0x80, 0xf1, 0x05, 0x58, 0xe8, 0xc2, 0x8d, 0xe2, 0xce, 0xb8, 0xe9, 0x40, 0x53, 0x27,
-	0x81, 0x9e, 0x61, 0xda, 0xd1, 0xb0, 0x33, 0x3e, 0x81, 0x89, 0xf1, 0x9d, 0x58, 0x92, 0xb8, 0x71,
-	0x65, 0x0c, 0xf8, 0x20, 0x66, 0xda, 0xe1, 0x26, 0x0b, 0x76, 0x4d, 0xcf, 0xef, 0x7f, 0x69, 0x0f,
-	0x2e, 0xcb, 0x94, 0x27, 0xbd, 0x88, 0x2b, 0x36, 0x4c, 0x45, 0x32, 0xa2, 0x71, 0x02, 0x06, 0xc8,
-	0x6e, 0x94, 0x44, 0xfa, 0x21, 0x0d, 0xe9, 0x7c, 0xea, 0x97, 0x25, 0x48, 0xb0, 0x43, 0x96, 0x9d,
-	0x1c, 0xe7, 0x57, 0x16, 0xea, 0xf9, 0x21, 0x1f, 0xec, 0x4b, 0x00, 0xd9, 0x17, 0x8c, 0xc7, 0x11,
-	0xe3, 0x4a, 0x81, 0xe1, 0x26, 0x02, 0xa5, 0xf3, 0xe9, 0x69, 0x17, 0xf4, 0x00, 0x34, 0x0b, 0xb9,
-	0x16, 0x2e, 0x97, 0x3d, 0x36, 0x43, 0x61, 0x78, 0x93, 0xc5, 0x5c, 0x46, 0xca, 0xc2, 0x8e, 0xad,
-	0xdf, 0x63, 0x72, 0x93, 0x11, 0xb7, 0x69, 0x2c, 0x12, 0xdd, 0x11, 0xc3, 0x54, 0x68, 0x43, 0x2e,
-	0x30, 0x5e, 0x92, 0x55, 0x54, 0x43, 0x8d, 0x52, 0xeb, 0x98, 0x3a, 0x5b, 0x9a, 0xd9, 0x52, 0xf7,
-	0x9c, 0xdc, 0x96, 0x5e, 0x73, 0x29, 0x72, 0x6d, 0x67, 0x45, 0x59, 0x7f, 0x47, 0x78, 0x6f, 0xcd,
-	0x5e, 0xc7, 0xa0, 0xb4, 0x20, 0xe7, 0xb8, 0xa8, 0xed, 0x4d, 0x15, 0xd5, 0xfe, 0x35, 0x4a, 0xad,
-	0x0a, 0xfd, 0xfb, 0x23, 0xd4, 0x2a, 0xda, 0xff, 0xc7, 0x5f, 0x07, 0x5e, 0x27, 0x87, 0xc9, 0xe5,
-	0x5a, 0xad, 0x82, 0xad, 0x75, 0xb2, 0xb5, 0x96, 0xcb, 0x5c, 0xed, 0xd5, 0x7a, 0x46, 0x78, 0xc7,
-	0xf6, 0x22, 0x4f, 0xb8, 0xe8, 0xba, 0x91, 0xc3, 0xcd, 0x0e, 0x9b, 0x3f, 0xe3, 0x1f, 0x6d, 0xa1,
-	0x5c, 0x58, 0xbd, 0xf6, 0xf2, 0xf1, 0xf3, 0x56, 0xf0, 0x49, 0x95, 0xe5, 0xf8, 0x62, 0x83, 0xcc,
-	0xbd, 0xa5, 0x7d, 0x35, 0x9e, 0x06, 0x68, 0x32, 0x0d, 0xd0, 0xf7, 0x34, 0x40, 0xaf, 0xb3, 0xc0,
-	0x9b, 0xcc, 0x02, 0xef, 0x73, 0x16, 0x78, 0x77, 0x4d, 0x19, 0x99, 0x2c, 0xa0, 0x0b, 0x03, 0xab,
-	0x56, 0xc2, 0x2c, 0x5c, 0x06, 0xd0, 0x4b, 0xfb, 0x42, 0x2f, 0xdd, 0xcc, 0x28, 0x16, 0x3a, 0x2c,
-	0xda, 0x65, 0x9e, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x85, 0xe3, 0x24, 0xf2, 0x6f, 0x02, 0x00,
-	0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0x14, 0x31,
+	0x14, 0xc7, 0x77, 0x5a, 0x9d, 0x43, 0x7a, 0xa8, 0xa4, 0x0b, 0x5d, 0xc6, 0x3a, 0x2e, 0x43, 0x5b,
+	0x8b, 0x68, 0xc2, 0xae, 0xe8, 0x07, 0xe8, 0xc1, 0x1e, 0x64, 0x61, 0xdd, 0xde, 0x44, 0x58, 0xb2,
+	0xdb, 0x10, 0x03, 0x9d, 0x24, 0x9d, 0x97, 0xb1, 0xf4, 0xea, 0x27, 0x10, 0xc4, 0x8b, 0x07, 0x3f,
+	0x4f, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x76, 0xfd, 0x20, 0xb2, 0x49, 0x76, 0xeb, 0x74, 0x5a, 0xc6,
+	0xdb, 0x90, 0xf7, 0x7f, 0xff, 0xff, 0x2f, 0xef, 0x65, 0x50, 0x5b, 0x94, 0xac, 0x38, 0x91, 0x4c,
+	0xd1, 0xb3, 0x92, 0x17, 0x17, 0xc4, 0x14, 0xda, 0x6a, 0xfc, 0x40, 0x16, 0x12, 0x3e, 0x94, 0x13,
+	0xb2, 0xac, 0x26, 0x6d, 0xa1, 0x85, 0x76, 0x45, 0xba, 0xf8, 0xf2, 0xba, 0x64, 0x7b, 0xd5, 0xbd,
+	0xfc, 0x08, 0x85, 0x1d, 0xa1, 0xb5, 0x38, 0xe5, 0x94, 0x19, 0x49, 0x99, 0x52, 0xda, 0x32, 0x2b,
+	0xb5, 0x82, 0x50, 0x7d, 0x3a, 0xd5, 0x90, 0x6b, 0xa0, 0x13, 0x06, 0xdc, 0xe7, 0xd2, 0x8f, 0xbd,
+	0x09, 0xb7, 0xac, 0x47, 0x0d, 0x13, 0x52, 0x39, 0xb1, 0xd7, 0x66, 0xef, 0x11, 0x7e, 0xbb, 0x50,
+	0x1c, 0x97, 0x86, 0x17, 0x30, 0xe2, 0x67, 0x25, 0x07, 0x8b, 0x5f, 0x23, 0x74, 0xad, 0xec, 0x44,
+	0xdd, 0xe8, 0x60, 0xa3, 0xbf, 0x4f, 0xbc, 0x2d, 0x59, 0xd8, 0x12, 0x7f, 0x9d, 0x60, 0x4b, 0x86,
+	0x4c, 0xf0, 0xd0, 0x3b, 0xfa, 0xa7, 0x33, 0xfb, 0x1a, 0xa1, 0xad, 0x8a, 0x3d, 0x18, 0xad, 0x80,
+	0xe3, 0x97, 0x28, 0x06, 0x77, 0xd2, 0x89, 0xba, 0xeb, 0x07, 0x1b, 0xfd, 0x6d, 0x72, 0x73, 0x22,
+	0xc4, 0x75, 0x1c, 0xde, 0xbb, 0xfc, 0xf5, 0xb8, 0x35, 0x0a, 0x62, 0x7c, 0x54, 0xc1, 0x5a, 0x73,
+	0x58, 0x4f, 0x1a, 0xb1, 0x7c, 0x66, 0x85, 0xab, 0x1d, 0x6e, 0x3d, 0x64, 0x05, 0xcb, 0x97, 0xb7,
+	0xce, 0x06, 0x68, 0xab, 0x72, 0x1a, 0x60, 0x5f, 0xa1, 0xd8, 0xb8, 0x93, 0x30, 0x88, 0x4e, 0x1d,
+	0xd6, 0x77, 0x2c, 0x69, 0xbd, 0x3a, 0x7b, 0x84, 0x1e, 0x3a, 0xbb, 0x01, 0x88, 0x81, 0x54, 0x47,
+	0x0c, 0x86, 0x85, 0x9c, 0xf2, 0x55, 0x1a, 0xa0, 0x9d, 0xdb, 0xcb, 0x21, 0xf6, 0x18, 0xe1, 0x1c,
+	0xc4, 0x38, 0x97, 0x6a, 0x2c, 0x18, 0x8c, 0x8d, 0xab, 0x86, 0x79, 0x75, 0xeb, 0x08, 0x55, 0x9b,
+	0x80, 0xb2, 0x99, 0x57, 0xcd, 0xfb, 0xdf, 0xd6, 0xd1, 0x7d, 0x97, 0x8a, 0xcf, 0x51, 0xec, 0x97,
+	0x82, 0x77, 0xeb, 0x66, 0xf5, 0x27, 0x91, 0xec, 0x35, 0xa8, 0x3c, 0x75, 0xd6, 0xfd, 0xf4, 0xe3,
+	0xcf, 0x97, 0xb5, 0x04, 0x77, 0x68, 0x90, 0xaf, 0x9e, 0x2e, 0x0d, 0x4b, 0x3c, 0x47, 0xb1, 0x1f,
+	0xd7, 0x9d, 0xc1, 0x95, 0xad, 0x24, 0x7b, 0x0d, 0xaa, 0xe6, 0x60, 0xbf, 0x0f, 0xfc, 0x3d, 0x42,
+	0x9b, 0x37, 0x86, 0x8d, 0x9f, 0xdf, 0x61, 0x7e, 0xfb, 0xce, 0x12, 0xf2, 0xbf, 0xf2, 0x00, 0xf5,
+	0xcc, 0x41, 0xed, 0xe3, 0xdd, 0x3a, 0x54, 0x7d, 0xb7, 0x87, 0x6f, 0x2e, 0x67, 0x69, 0x74, 0x35,
+	0x4b, 0xa3, 0xdf, 0xb3, 0x34, 0xfa, 0x3c, 0x4f, 0x5b, 0x57, 0xf3, 0xb4, 0xf5, 0x73, 0x9e, 0xb6,
+	0xde, 0xf5, 0x84, 0xb4, 0x8b, 0xd4, 0xa9, 0xce, 0x9d, 0x93, 0xe2, 0x76, 0xe5, 0x98, 0xeb, 0x93,
+	0xf2, 0x94, 0xc3, 0xb5, 0xb3, 0xbd, 0x30, 0x1c, 0x26, 0xb1, 0xfb, 0xbf, 0x5f, 0xfc, 0x1d, 0x00,
+	0x41, 0x63, 0xe2, 0xef, 0x82, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +353,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Supers returns all Supers
 	Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error)
+	// Params queries the guardian parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MsgMinGasPrices queries the minimum gas prices of the message types
+	MsgMinGasPrices(ctx context.Context, in *QueryMsgMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMsgMinGasPricesResponse, error)
 }
 
 type queryClient struct {
@@ -196,10 +376,32 @@ func (c *queryClient) Supers(ctx context.Context, in *QuerySupersRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgMinGasPrices(ctx context.Context, in *QueryMsgMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMsgMinGasPricesResponse, error) {
+	out := new(QueryMsgMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/MsgMinGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Supers returns all Supers
 	Supers(context.Context, *QuerySupersRequest) (*QuerySupersResponse, error)
+	// Params queries the guardian parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MsgMinGasPrices queries the minimum gas prices of the message types
+	MsgMinGasPrices(context.Context, *QueryMsgMinGasPricesRequest) (*QueryMsgMinGasPricesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -209,6 +411,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Supers(ctx context.Context, req *QuerySupersRequest) (*QuerySupersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Supers not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MsgMinGasPrices(ctx context.Context, req *QueryMsgMinGasPricesRequest) (*QueryMsgMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgMinGasPrices not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -232,6 +440,42 @@ func _Query_Supers_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgMinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgMinGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgMinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/MsgMinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgMinGasPrices(ctx, req.(*QueryMsgMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "irishub.guardian.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -240,6 +484,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Supers",
 			Handler:    _Query_Supers_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MsgMinGasPrices",
+			Handler:    _Query_MsgMinGasPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "guardian/query.proto",
@@ -329,6 +581,122 @@ func (m *QuerySupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgMinGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgMinGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgMinGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgMinGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgMinGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgMinGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgMinGasPrices) > 0 {
+		for iNdEx := len(m.MsgMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -372,20 +740,64 @@ func (m *QuerySupersResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *QueryMsgMinGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMsgMinGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgMinGasPrices) > 0 {
+		for _, e := range m.MsgMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -584,6 +996,273 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgMinGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgMinGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgMinGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgMinGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgMinGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgMinGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgMinGasPrices = append(m.MsgMinGasPrices, MsgMinGasPrice{})
+			if err := m.MsgMinGasPrices[len(m.MsgMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/memo/types"
+)
+
+// GetQueryCmd returns the cli query commands for the memo module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the memo module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryMemoRequired(),
+		GetCmdQueryMemoRequiredAddresses(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryMemoRequired implements the query memo required command.
+func GetCmdQueryMemoRequired() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "required [address]",
+		Short:   "Query whether the account requires memos on incoming transfers",
+		Example: fmt.Sprintf("%s query memo required <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MemoRequired(context.Background(), &types.QueryMemoRequiredRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMemoRequiredAddresses implements the query memo required addresses command.
+func GetCmdQueryMemoRequiredAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "required-addresses",
+		Short:   "Query all the accounts requiring memos on incoming transfers",
+		Example: fmt.Sprintf("%s query memo required-addresses", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MemoRequiredAddresses(context.Background(), &types.QueryMemoRequiredAddressesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "required-addresses")
+	return cmd
+}
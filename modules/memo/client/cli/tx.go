@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/memo/types"
+)
+
+// NewTxCmd returns the transaction commands for the memo module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "memo transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSetMemoRequired(),
+	)
+	return txCmd
+}
+
+// GetCmdSetMemoRequired implements the set memo required command.
+func GetCmdSetMemoRequired() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-required [true|false]",
+		Short: "Opt the sender in or out of requiring memos on incoming transfers",
+		Long:  "Opt the sender in or out of requiring memos on incoming transfers, bank sends to an account requiring memos are rejected if the memo is empty.",
+		Example: fmt.Sprintf(
+			"%s tx memo set-required true --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			required, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMemoRequired(clientCtx.GetFromAddress(), required)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
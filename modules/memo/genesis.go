@@ -0,0 +1,36 @@
+package memo
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/memo/keeper"
+	"github.com/irisnet/irishub/modules/memo/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize memo genesis state: %s", err.Error()))
+	}
+
+	for _, address := range data.MemoRequiredAddresses {
+		addr, _ := sdk.AccAddressFromBech32(address)
+		k.SetMemoRequired(ctx, addr, true)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var addresses []string
+	k.IterateMemoRequiredAddresses(
+		ctx,
+		func(address sdk.AccAddress) bool {
+			addresses = append(addresses, address.String())
+			return false
+		},
+	)
+
+	return types.NewGenesisState(addresses)
+}
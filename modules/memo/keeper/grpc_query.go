@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/memo/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// MemoRequired implements the Query/MemoRequired gRPC method
+func (k Keeper) MemoRequired(c context.Context, req *types.QueryMemoRequiredRequest) (*types.QueryMemoRequiredResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMemoRequiredResponse{Required: k.IsMemoRequired(ctx, address)}, nil
+}
+
+// MemoRequiredAddresses implements the Query/MemoRequiredAddresses gRPC method
+func (k Keeper) MemoRequiredAddresses(c context.Context, req *types.QueryMemoRequiredAddressesRequest) (*types.QueryMemoRequiredAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var addresses []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MemoRequiredKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryMemoRequiredAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
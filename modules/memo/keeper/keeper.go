@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/memo/types"
+)
+
+// Keeper of the memo store
+type Keeper struct {
+	cdc      codec.Marshaler
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper returns a memo keeper
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// SetMemoRequired opts the given address in or out of requiring memos on incoming transfers
+func (k Keeper) SetMemoRequired(ctx sdk.Context, address sdk.AccAddress, required bool) {
+	store := ctx.KVStore(k.storeKey)
+	if required {
+		store.Set(types.GetMemoRequiredKey(address), []byte{0x01})
+	} else {
+		store.Delete(types.GetMemoRequiredKey(address))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetMemoRequired,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyRequired, strconv.FormatBool(required)),
+		),
+	)
+}
+
+// IsMemoRequired returns true if the given address requires memos on incoming transfers
+func (k Keeper) IsMemoRequired(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetMemoRequiredKey(address))
+}
+
+// IterateMemoRequiredAddresses iterates through all the addresses requiring memos on incoming transfers
+func (k Keeper) IterateMemoRequiredAddresses(ctx sdk.Context, op func(address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.MemoRequiredKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[len(types.MemoRequiredKeyPrefix):])
+		if stop := op(address); stop {
+			break
+		}
+	}
+}
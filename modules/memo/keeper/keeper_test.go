@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/memo/keeper"
+	"github.com/irisnet/irishub/modules/memo/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.keeper = app.MemoKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetMemoRequired() {
+	suite.False(suite.keeper.IsMemoRequired(suite.ctx, addr1))
+
+	suite.keeper.SetMemoRequired(suite.ctx, addr1, true)
+	suite.True(suite.keeper.IsMemoRequired(suite.ctx, addr1))
+	suite.False(suite.keeper.IsMemoRequired(suite.ctx, addr2))
+
+	suite.keeper.SetMemoRequired(suite.ctx, addr1, false)
+	suite.False(suite.keeper.IsMemoRequired(suite.ctx, addr1))
+}
+
+func (suite *KeeperTestSuite) TestMsgSetMemoRequired() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	_, err := msgServer.SetMemoRequired(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetMemoRequired(addr1, true))
+	suite.NoError(err)
+	suite.True(suite.keeper.IsMemoRequired(suite.ctx, addr1))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMemoRequired() {
+	suite.keeper.SetMemoRequired(suite.ctx, addr1, true)
+	suite.keeper.SetMemoRequired(suite.ctx, addr2, true)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.keeper.MemoRequired(ctx, &types.QueryMemoRequiredRequest{Address: addr1.String()})
+	suite.NoError(err)
+	suite.True(res.Required)
+
+	resAll, err := suite.keeper.MemoRequiredAddresses(ctx, &types.QueryMemoRequiredAddressesRequest{})
+	suite.NoError(err)
+	suite.ElementsMatch([]string{addr1.String(), addr2.String()}, resAll.Addresses)
+}
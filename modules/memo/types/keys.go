@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "memo"
+
+	// StoreKey is the default store key for memo
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for memo
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the memo store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	MemoRequiredKeyPrefix = []byte{0x01} // memo required key prefix
+)
+
+// GetMemoRequiredKey returns the key of the memo required flag of the given address
+func GetMemoRequiredKey(address sdk.AccAddress) []byte {
+	return append(MemoRequiredKeyPrefix, address.Bytes()...)
+}
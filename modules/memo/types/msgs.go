@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetMemoRequired = "set_memo_required" // type for MsgSetMemoRequired
+)
+
+var _ sdk.Msg = &MsgSetMemoRequired{}
+
+// NewMsgSetMemoRequired constructs a MsgSetMemoRequired
+func NewMsgSetMemoRequired(address sdk.AccAddress, required bool) *MsgSetMemoRequired {
+	return &MsgSetMemoRequired{
+		Address:  address.String(),
+		Required: required,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetMemoRequired) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetMemoRequired) Type() string { return TypeMsgSetMemoRequired }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetMemoRequired) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetMemoRequired) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetMemoRequired) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
@@ -0,0 +1,1035 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: memo/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMemoRequiredRequest is request type for the Query/MemoRequired RPC method
+type QueryMemoRequiredRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMemoRequiredRequest) Reset()         { *m = QueryMemoRequiredRequest{} }
+func (m *QueryMemoRequiredRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemoRequiredRequest) ProtoMessage()    {}
+func (*QueryMemoRequiredRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45466e3f98fcab39, []int{0}
+}
+func (m *QueryMemoRequiredRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemoRequiredRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemoRequiredRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemoRequiredRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemoRequiredRequest.Merge(m, src)
+}
+func (m *QueryMemoRequiredRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemoRequiredRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemoRequiredRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemoRequiredRequest proto.InternalMessageInfo
+
+func (m *QueryMemoRequiredRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMemoRequiredResponse is response type for the Query/MemoRequired RPC method
+type QueryMemoRequiredResponse struct {
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (m *QueryMemoRequiredResponse) Reset()         { *m = QueryMemoRequiredResponse{} }
+func (m *QueryMemoRequiredResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemoRequiredResponse) ProtoMessage()    {}
+func (*QueryMemoRequiredResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45466e3f98fcab39, []int{1}
+}
+func (m *QueryMemoRequiredResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemoRequiredResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemoRequiredResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemoRequiredResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemoRequiredResponse.Merge(m, src)
+}
+func (m *QueryMemoRequiredResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemoRequiredResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemoRequiredResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemoRequiredResponse proto.InternalMessageInfo
+
+func (m *QueryMemoRequiredResponse) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+// QueryMemoRequiredAddressesRequest is request type for the Query/MemoRequiredAddresses RPC method
+type QueryMemoRequiredAddressesRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMemoRequiredAddressesRequest) Reset()         { *m = QueryMemoRequiredAddressesRequest{} }
+func (m *QueryMemoRequiredAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemoRequiredAddressesRequest) ProtoMessage()    {}
+func (*QueryMemoRequiredAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45466e3f98fcab39, []int{2}
+}
+func (m *QueryMemoRequiredAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemoRequiredAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemoRequiredAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemoRequiredAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemoRequiredAddressesRequest.Merge(m, src)
+}
+func (m *QueryMemoRequiredAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemoRequiredAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemoRequiredAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemoRequiredAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryMemoRequiredAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMemoRequiredAddressesResponse is response type for the Query/MemoRequiredAddresses RPC method
+type QueryMemoRequiredAddressesResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMemoRequiredAddressesResponse) Reset()         { *m = QueryMemoRequiredAddressesResponse{} }
+func (m *QueryMemoRequiredAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemoRequiredAddressesResponse) ProtoMessage()    {}
+func (*QueryMemoRequiredAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45466e3f98fcab39, []int{3}
+}
+func (m *QueryMemoRequiredAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemoRequiredAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemoRequiredAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemoRequiredAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemoRequiredAddressesResponse.Merge(m, src)
+}
+func (m *QueryMemoRequiredAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemoRequiredAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemoRequiredAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemoRequiredAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryMemoRequiredAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryMemoRequiredAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryMemoRequiredRequest)(nil), "irishub.memo.QueryMemoRequiredRequest")
+	proto.RegisterType((*QueryMemoRequiredResponse)(nil), "irishub.memo.QueryMemoRequiredResponse")
+	proto.RegisterType((*QueryMemoRequiredAddressesRequest)(nil), "irishub.memo.QueryMemoRequiredAddressesRequest")
+	proto.RegisterType((*QueryMemoRequiredAddressesResponse)(nil), "irishub.memo.QueryMemoRequiredAddressesResponse")
+}
+
+func init() { proto.RegisterFile("memo/query.proto", fileDescriptor_45466e3f98fcab39) }
+
+var fileDescriptor_45466e3f98fcab39 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6b, 0xdb, 0x40,
+	0x14, 0xc7, 0x7d, 0x2e, 0x6d, 0xed, 0xab, 0x87, 0x72, 0xd0, 0xa2, 0x0a, 0x23, 0x5c, 0x0d, 0xb6,
+	0x29, 0xf4, 0xae, 0x76, 0x0b, 0x9d, 0xdb, 0xa1, 0x9e, 0x0a, 0xad, 0xc6, 0x6e, 0x27, 0xeb, 0xa1,
+	0x8a, 0x5a, 0x3a, 0x59, 0x77, 0x2a, 0x98, 0xd2, 0x25, 0x5b, 0xc8, 0x12, 0xc8, 0x96, 0x4f, 0x91,
+	0x8f, 0x91, 0xd1, 0x90, 0x25, 0x63, 0xb0, 0xf3, 0x41, 0x82, 0xee, 0xa4, 0x58, 0x49, 0x9c, 0x38,
+	0x93, 0xb8, 0xa7, 0xf7, 0xff, 0xff, 0x7f, 0xef, 0xde, 0xe1, 0x97, 0x31, 0xc4, 0x82, 0xcd, 0x73,
+	0xc8, 0x16, 0x34, 0xcd, 0x84, 0x12, 0xa4, 0x13, 0x65, 0x91, 0xfc, 0x9d, 0xfb, 0xb4, 0xf8, 0x63,
+	0x77, 0x43, 0x21, 0xc2, 0x19, 0x30, 0x9e, 0x46, 0x8c, 0x27, 0x89, 0x50, 0x5c, 0x45, 0x22, 0x91,
+	0xa6, 0xd7, 0x7e, 0x37, 0x15, 0x32, 0x16, 0x92, 0xf9, 0x5c, 0x82, 0x31, 0x61, 0x7f, 0x47, 0x3e,
+	0x28, 0x3e, 0x62, 0x29, 0x0f, 0xa3, 0x44, 0x37, 0x9b, 0x5e, 0xf7, 0x13, 0xb6, 0x7e, 0x16, 0x1d,
+	0xdf, 0x21, 0x16, 0x1e, 0xcc, 0xf3, 0x28, 0x83, 0xa0, 0xf8, 0x82, 0x54, 0xc4, 0xc2, 0xcf, 0x79,
+	0x10, 0x64, 0x20, 0xa5, 0x85, 0x7a, 0x68, 0xd8, 0xf6, 0xaa, 0xa3, 0xfb, 0x19, 0xbf, 0xd9, 0xa2,
+	0x92, 0xa9, 0x48, 0x24, 0x10, 0x1b, 0xb7, 0xb2, 0xb2, 0xa6, 0x75, 0x2d, 0xef, 0xfa, 0xec, 0xfe,
+	0xc1, 0x6f, 0xef, 0x08, 0xbf, 0x18, 0x53, 0x90, 0x55, 0xee, 0x37, 0x8c, 0x37, 0x9c, 0xda, 0xe2,
+	0xc5, 0xb8, 0x4f, 0xcd, 0x50, 0xb4, 0x18, 0x8a, 0x9a, 0x9b, 0x29, 0x87, 0xa2, 0x3f, 0x78, 0x08,
+	0xa5, 0xd6, 0xab, 0x29, 0xdd, 0x03, 0x84, 0xdd, 0x87, 0xd2, 0x4a, 0xde, 0x2e, 0x6e, 0xf3, 0xaa,
+	0x68, 0xa1, 0xde, 0x93, 0x61, 0xdb, 0xdb, 0x14, 0xc8, 0xe4, 0x06, 0x4c, 0x53, 0xc3, 0x0c, 0x76,
+	0xc2, 0x18, 0xeb, 0x3a, 0xcd, 0xf8, 0xa4, 0x89, 0x9f, 0x6a, 0x1a, 0xb2, 0x8f, 0x70, 0xa7, 0x8e,
+	0x44, 0xfa, 0xb4, 0xbe, 0x5d, 0x7a, 0xdf, 0x42, 0xec, 0xc1, 0xce, 0x3e, 0x93, 0xeb, 0x0e, 0xf7,
+	0xce, 0x2e, 0x8f, 0x9a, 0x2e, 0xe9, 0xb1, 0x52, 0xc0, 0xf4, 0x83, 0xaa, 0xd6, 0xc0, 0xfe, 0x95,
+	0xf3, 0xfd, 0x27, 0xc7, 0x08, 0xbf, 0xda, 0x7a, 0x3d, 0x84, 0xed, 0x08, 0xbb, 0xbd, 0x36, 0xfb,
+	0xc3, 0xe3, 0x05, 0x25, 0xa6, 0xa3, 0x31, 0x2d, 0xf2, 0x7a, 0x3b, 0xe6, 0xd7, 0xc9, 0xe9, 0xca,
+	0x41, 0xcb, 0x95, 0x83, 0x2e, 0x56, 0x0e, 0x3a, 0x5c, 0x3b, 0x8d, 0xe5, 0xda, 0x69, 0x9c, 0xaf,
+	0x9d, 0xc6, 0xaf, 0xf7, 0x61, 0xa4, 0x8a, 0xa4, 0xa9, 0x88, 0xb5, 0x36, 0x01, 0xb5, 0xf1, 0x10,
+	0x41, 0x3e, 0x03, 0x69, 0xbc, 0xd4, 0x22, 0x05, 0xe9, 0x3f, 0xd3, 0x8f, 0xfd, 0xe3, 0xd5, 0x00,
+	0x59, 0xf5, 0xd0, 0x9b, 0x58, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MemoRequired returns whether the account requires memos on incoming transfers
+	MemoRequired(ctx context.Context, in *QueryMemoRequiredRequest, opts ...grpc.CallOption) (*QueryMemoRequiredResponse, error)
+	// MemoRequiredAddresses returns all the accounts requiring memos on incoming transfers
+	MemoRequiredAddresses(ctx context.Context, in *QueryMemoRequiredAddressesRequest, opts ...grpc.CallOption) (*QueryMemoRequiredAddressesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MemoRequired(ctx context.Context, in *QueryMemoRequiredRequest, opts ...grpc.CallOption) (*QueryMemoRequiredResponse, error) {
+	out := new(QueryMemoRequiredResponse)
+	err := c.cc.Invoke(ctx, "/irishub.memo.Query/MemoRequired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemoRequiredAddresses(ctx context.Context, in *QueryMemoRequiredAddressesRequest, opts ...grpc.CallOption) (*QueryMemoRequiredAddressesResponse, error) {
+	out := new(QueryMemoRequiredAddressesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.memo.Query/MemoRequiredAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MemoRequired returns whether the account requires memos on incoming transfers
+	MemoRequired(context.Context, *QueryMemoRequiredRequest) (*QueryMemoRequiredResponse, error)
+	// MemoRequiredAddresses returns all the accounts requiring memos on incoming transfers
+	MemoRequiredAddresses(context.Context, *QueryMemoRequiredAddressesRequest) (*QueryMemoRequiredAddressesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MemoRequired(ctx context.Context, req *QueryMemoRequiredRequest) (*QueryMemoRequiredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoRequired not implemented")
+}
+func (*UnimplementedQueryServer) MemoRequiredAddresses(ctx context.Context, req *QueryMemoRequiredAddressesRequest) (*QueryMemoRequiredAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoRequiredAddresses not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MemoRequired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemoRequiredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemoRequired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.memo.Query/MemoRequired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemoRequired(ctx, req.(*QueryMemoRequiredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemoRequiredAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemoRequiredAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemoRequiredAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.memo.Query/MemoRequiredAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemoRequiredAddresses(ctx, req.(*QueryMemoRequiredAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.memo.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MemoRequired",
+			Handler:    _Query_MemoRequired_Handler,
+		},
+		{
+			MethodName: "MemoRequiredAddresses",
+			Handler:    _Query_MemoRequiredAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "memo/query.proto",
+}
+
+func (m *QueryMemoRequiredRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemoRequiredRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemoRequiredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemoRequiredResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemoRequiredResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemoRequiredResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemoRequiredAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemoRequiredAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemoRequiredAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemoRequiredAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemoRequiredAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemoRequiredAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMemoRequiredRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemoRequiredResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Required {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMemoRequiredAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemoRequiredAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMemoRequiredRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemoRequiredRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemoRequiredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemoRequiredResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemoRequiredResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemoRequiredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemoRequiredAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemoRequiredAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemoRequiredAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemoRequiredAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemoRequiredAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemoRequiredAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,13 @@
 package mint
 
 import (
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irisnet/irishub/modules/mint/keeper"
@@ -9,45 +16,87 @@ import (
 
 // BeginBlocker handles block beginning logic for mint
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
 	logger := k.Logger(ctx)
 	// Get block BFT time and block height
 	blockTime := ctx.BlockHeader().Time
-	minter := k.GetMinter(ctx)
+	params := k.GetParamSet(ctx)
 	if ctx.BlockHeight() <= 1 { // don't inflate token in the first block
-		minter.LastUpdate = blockTime
-		k.SetMinter(ctx, minter)
+		for _, schedule := range params.Schedules {
+			k.SetMinter(ctx, types.NewMinter(schedule.Denom, blockTime))
+		}
 		return
 	}
 
-	// Calculate block mint amount
-	params := k.GetParamSet(ctx)
-	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom)
+	collectedCoins := sdk.NewCoins()
+	for _, schedule := range params.Schedules {
+		// the param change proposals are not checked against the tokens, so the schedules of
+		// the denoms which are not the min units of registered tokens are skipped
+		if err := k.ValidateMintDenom(ctx, schedule.Denom); err != nil {
+			logger.Error("Skip mint schedule", "mint_denom", schedule.Denom, "err", err.Error())
+			continue
+		}
+
+		minter, found := k.GetMinter(ctx, schedule.Denom)
+		if !found {
+			minter = types.NewMinter(schedule.Denom, blockTime)
+		}
+
+		// Calculate block mint amount
+		logger.Info("Mint parameters", "inflation_rate", schedule.Inflation.String(), "mint_denom", schedule.Denom)
 
-	mintedCoin := minter.BlockProvision(params)
-	logger.Info("Mint result", "block_provisions", mintedCoin.String(), "time", blockTime.String())
+		mintedCoin := schedule.BlockProvision()
+		logger.Info("Mint result", "block_provisions", mintedCoin.String(), "time", blockTime.String())
 
-	mintedCoins := sdk.NewCoins(mintedCoin)
-	// mint coins to submodule account
-	if err := k.MintCoins(ctx, mintedCoins); err != nil {
-		panic(err)
+		mintedCoins := sdk.NewCoins(mintedCoin)
+		// mint coins to submodule account
+		if err := k.MintCoins(ctx, mintedCoins); err != nil {
+			panic(err)
+		}
+
+		// send the minted coins to the recipient of the schedule
+		recipient := schedule.RecipientAddress()
+		if err := k.DistributeMintedCoins(ctx, recipient, mintedCoins); err != nil {
+			panic(err)
+		}
+		if recipient.Empty() {
+			collectedCoins = collectedCoins.Add(mintedCoins...)
+		}
+
+		setMintMetrics(schedule.Denom, schedule.Inflation, mintedCoin.Amount)
+
+		// Update last block BFT time
+		lastInflationTime := minter.LastUpdate
+		minter.LastUpdate = blockTime
+		k.SetMinter(ctx, minter)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMint,
+				sdk.NewAttribute(types.AttributeKeyLastInflationTime, lastInflationTime.String()),
+				sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
+				sdk.NewAttribute(types.AttributeKeyMintCoin, mintedCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyMintDenom, mintedCoin.Denom),
+				sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient),
+			),
+		)
 	}
 
-	// send the minted coins to the fee collector account
-	if err := k.AddCollectedFees(ctx, mintedCoins); err != nil {
-		panic(err)
+	// notify the registered hooks of the new issuance
+	if !collectedCoins.Empty() {
+		k.AfterMint(ctx, collectedCoins)
 	}
+}
+
+// setMintMetrics emits the minted amount and the current inflation of the denom
+func setMintMetrics(denom string, inflation sdk.Dec, minted sdk.Int) {
+	labels := []metrics.Label{telemetry.NewLabel("denom", denom)}
+
+	// the minted amount is converted through big.Float, so that it never overflows
+	amount, _ := new(big.Float).SetInt(minted.BigInt()).Float32()
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "minted_tokens"}, amount, labels)
 
-	// Update last block BFT time
-	lastInflationTime := minter.LastUpdate
-	minter.LastUpdate = blockTime
-	k.SetMinter(ctx, minter)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMint,
-			sdk.NewAttribute(types.AttributeKeyLastInflationTime, lastInflationTime.String()),
-			sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
-			sdk.NewAttribute(types.AttributeKeyMintCoin, mintedCoin.Amount.String()),
-		),
-	)
+	rate, _ := strconv.ParseFloat(inflation.String(), 32)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "inflation"}, float32(rate), labels)
 }
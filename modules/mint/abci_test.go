@@ -7,10 +7,13 @@ import (
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
 	"github.com/irisnet/irishub/modules/mint"
 	"github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
@@ -20,13 +23,67 @@ func TestBeginBlocker(t *testing.T) {
 	app, ctx := createTestApp(true)
 
 	mint.BeginBlocker(ctx, app.MintKeeper)
-	minter := app.MintKeeper.GetMinter(ctx)
 	param := app.MintKeeper.GetParamSet(ctx)
-	mintCoins := minter.BlockProvision(param)
+	mintCoins := param.BlockProvision()
 
 	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
 	mintedCoins := app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress())
-	require.Equal(t, mintedCoins, sdk.NewCoins(mintCoins))
+	require.Equal(t, mintedCoins, mintCoins)
+
+	minter, found := app.MintKeeper.GetMinter(ctx, sdk.DefaultBondDenom)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time, minter.LastUpdate)
+}
+
+func TestBeginBlockerWithRecipient(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	recipient := sdk.AccAddress([]byte("mint-recipient______"))
+	schedule := types.NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), sdk.NewIntWithDecimal(20, 14), recipient.String())
+	app.MintKeeper.SetParamSet(ctx, types.NewParams(schedule))
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress()).Empty())
+	require.Equal(t, sdk.NewCoins(schedule.BlockProvision()), app.BankKeeper.GetAllBalances(ctx, recipient))
+}
+
+type mockMintHooks struct {
+	minted sdk.Coins
+}
+
+func (h *mockMintHooks) AfterMint(_ sdk.Context, coins sdk.Coins) {
+	h.minted = h.minted.Add(coins...)
+}
+
+func TestBeginBlockerWithHooks(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	hooks1, hooks2 := &mockMintHooks{}, &mockMintHooks{}
+	app.MintKeeper.SetHooks(types.NewMultiMintHooks(hooks1, hooks2))
+	require.Panics(t, func() { app.MintKeeper.SetHooks(hooks1) })
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	param := app.MintKeeper.GetParamSet(ctx)
+	mintCoins := param.BlockProvision()
+
+	require.Equal(t, mintCoins, hooks1.minted)
+	require.Equal(t, mintCoins, hooks2.minted)
+}
+
+func TestBeginBlockerUnregisteredDenom(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	schedule := types.NewMintSchedule("unregistered", sdk.NewDecWithPrec(4, 2), sdk.NewIntWithDecimal(20, 14), "")
+	app.MintKeeper.SetParamSet(ctx, types.NewParams(schedule))
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress()).Empty())
+	_, found := app.MintKeeper.GetMinter(ctx, "unregistered")
+	require.False(t, found)
 }
 
 // returns context and an app with updated mint keeper
@@ -34,12 +91,27 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app := simapp.Setup(isCheckTx)
 
 	ctx := app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 2})
+	// the schedules only mint the min units of the registered tokens
+	if err := app.TokenKeeper.AddToken(ctx, tokentypes.GetNativeToken()); err != nil {
+		panic(err)
+	}
 	app.MintKeeper.SetParamSet(ctx, types.NewParams(
-		sdk.DefaultBondDenom,
-		sdk.NewDecWithPrec(4, 2),
+		types.NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), sdk.NewIntWithDecimal(20, 14), ""),
 	))
-	app.MintKeeper.SetMinter(ctx, types.DefaultMinter())
 	app.BankKeeper.SetSupply(ctx, &banktypes.Supply{})
 	app.DistrKeeper.SetFeePool(ctx, distributiontypes.InitialFeePool())
 	return app, ctx
 }
+
+func TestBeginBlockerMetrics(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "iris", PrometheusRetentionTime: 60})
+	require.NoError(t, err)
+
+	app, ctx := createTestApp(true)
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	gr, err := m.Gather(telemetry.FormatPrometheus)
+	require.NoError(t, err)
+	require.Contains(t, string(gr.Metrics), `iris_mint_minted_tokens{denom="stake"}`)
+	require.Contains(t, string(gr.Metrics), `iris_mint_inflation{denom="stake"} 0.0399`)
+}
@@ -50,6 +50,7 @@ func (s *IntegrationTestSuite) TestMint() {
 	s.Require().NoError(err)
 	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
 	params := respType.(*minttypes.Params)
-	s.Require().Equal("stake", params.MintDenom)
-	s.Require().Equal("0.040000000000000000", params.Inflation.String())
+	s.Require().Len(params.Schedules, 1)
+	s.Require().Equal("stake", params.Schedules[0].Denom)
+	s.Require().Equal("0.040000000000000000", params.Schedules[0].Inflation.String())
 }
@@ -53,6 +53,7 @@ func (s *IntegrationTestSuite) TestHtlc() {
 	s.Require().NoError(err)
 	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, respType))
 	paramsResp := respType.(*minttypes.QueryParamsResponse)
-	s.Require().Equal("stake", paramsResp.Params.MintDenom)
-	s.Require().Equal("0.040000000000000000", paramsResp.Params.Inflation.String())
+	s.Require().Len(paramsResp.Params.Schedules, 1)
+	s.Require().Equal("stake", paramsResp.Params.Schedules[0].Denom)
+	s.Require().Equal("0.040000000000000000", paramsResp.Params.Schedules[0].Inflation.String())
 }
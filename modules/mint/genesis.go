@@ -1,7 +1,6 @@
 package mint
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/irisnet/irishub/modules/mint/keeper"
@@ -15,22 +14,26 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	if err := ValidateGenesis(data); err != nil {
 		panic(fmt.Errorf("failed to initialize mint genesis state: %s", err.Error()))
 	}
-	keeper.SetMinter(ctx, data.Minter)
+	for _, schedule := range data.Params.Schedules {
+		if err := keeper.ValidateMintDenom(ctx, schedule.Denom); err != nil {
+			panic(fmt.Errorf("failed to initialize mint genesis state: %s", err.Error()))
+		}
+	}
+	for _, minter := range data.Minters {
+		keeper.SetMinter(ctx, minter)
+	}
 	keeper.SetParamSet(ctx, data.Params)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
-	minter := keeper.GetMinter(ctx)
+	minters := keeper.GetMinters(ctx)
 	params := keeper.GetParamSet(ctx)
-	return types.NewGenesisState(minter, params)
+	return types.NewGenesisState(minters, params)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
-	if !data.Minter.InflationBase.IsPositive() {
-		return errors.New("base inflation must be positive")
-	}
-	return data.Params.Validate()
+	return types.ValidateGenesis(data)
 }
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AfterMint calls the registered hooks, if any, after the minted coins
+// have been sent to the fee collector
+func (k Keeper) AfterMint(ctx sdk.Context, coins sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterMint(ctx, coins)
+	}
+}
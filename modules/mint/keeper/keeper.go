@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
@@ -18,13 +19,15 @@ type Keeper struct {
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
 	bankKeeper       types.BankKeeper
+	tokenKeeper      types.TokenKeeper
 	feeCollectorName string
+	hooks            types.MintHooks
 }
 
 // NewKeeper returns a mint keeper
 func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string) Keeper {
+	tk types.TokenKeeper, feeCollectorName string) Keeper {
 
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -36,6 +39,7 @@ func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 		cdc:              cdc,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		bankKeeper:       bk,
+		tokenKeeper:      tk,
 		feeCollectorName: feeCollectorName,
 	}
 	return keeper
@@ -46,24 +50,59 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
 }
 
+// SetHooks sets the mint hooks
+func (k *Keeper) SetHooks(mh types.MintHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set mint hooks twice")
+	}
+	k.hooks = mh
+	return k
+}
+
 // ______________________________________________________________________
 
-// GetMinter returns the minter
-func (k Keeper) GetMinter(ctx sdk.Context) (minter types.Minter) {
+// GetMinter returns the minter of the given denom
+func (k Keeper) GetMinter(ctx sdk.Context, denom string) (minter types.Minter, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	b := store.Get(types.MinterKey)
+	b := store.Get(types.GetMinterKey(denom))
 	if b == nil {
-		panic("Stored minter should not have been nil")
+		return minter, false
 	}
 	k.cdc.MustUnmarshalBinaryBare(b, &minter)
-	return
+	return minter, true
 }
 
 // SetMinter set the minter
 func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshalBinaryBare(&minter)
-	store.Set(types.MinterKey, b)
+	store.Set(types.GetMinterKey(minter.Denom), b)
+}
+
+// GetMinters returns the minters of all the denoms
+func (k Keeper) GetMinters(ctx sdk.Context) (minters []types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MinterKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var minter types.Minter
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &minter)
+		minters = append(minters, minter)
+	}
+	return
+}
+
+// ValidateMintDenom checks that the denom is the min unit of a registered token
+func (k Keeper) ValidateMintDenom(ctx sdk.Context, denom string) error {
+	token, err := k.tokenKeeper.GetToken(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidMintDenom, "denom %s is not a registered token", denom)
+	}
+	if token.GetMinUnit() != denom {
+		return sdkerrors.Wrapf(types.ErrInvalidMintDenom, "denom %s is not the min unit of token %s", denom, token.GetSymbol())
+	}
+	return nil
 }
 
 // MintCoins implements an alias call to the underlying supply keeper's
@@ -82,6 +121,15 @@ func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins)
 }
 
+// DistributeMintedCoins sends the minted coins to the recipient, or to the
+// fee collector if the recipient is empty
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	if recipient.Empty() {
+		return k.AddCollectedFees(ctx, coins)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
 // GetParamSet returns inflation params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -89,6 +137,12 @@ func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	return params
 }
 
+// HasSchedules returns true if the mint schedules are set, which is false on a chain
+// started before the mint schedules until they are migrated by MigrateSchedules
+func (k Keeper) HasSchedules(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeySchedules)
+}
+
 // SetParamSet set inflation params from the global param store
 func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
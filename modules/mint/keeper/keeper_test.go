@@ -33,7 +33,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.app = app
 
 	app.MintKeeper.SetParamSet(suite.ctx, types.DefaultParams())
-	app.MintKeeper.SetMinter(suite.ctx, types.DefaultMinter())
+	for _, minter := range types.DefaultMinters() {
+		app.MintKeeper.SetMinter(suite.ctx, minter)
+	}
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -41,11 +43,17 @@ func TestKeeperTestSuite(t *testing.T) {
 }
 
 func (suite *KeeperTestSuite) TestSetGetMinter() {
-	minter := types.NewMinter(time.Now().UTC(), sdk.NewInt(100000))
+	minter := types.NewMinter(sdk.DefaultBondDenom, time.Now().UTC())
 	suite.app.MintKeeper.SetMinter(suite.ctx, minter)
-	expMinter := suite.app.MintKeeper.GetMinter(suite.ctx)
-
+	expMinter, found := suite.app.MintKeeper.GetMinter(suite.ctx, minter.Denom)
+	require.True(suite.T(), found)
 	require.Equal(suite.T(), minter, expMinter)
+
+	_, found = suite.app.MintKeeper.GetMinter(suite.ctx, "unknown")
+	require.False(suite.T(), found)
+
+	minters := suite.app.MintKeeper.GetMinters(suite.ctx)
+	require.Equal(suite.T(), []types.Minter{minter}, minters)
 }
 
 func (suite *KeeperTestSuite) TestSetGetParamSet() {
@@ -91,3 +99,8 @@ func (suite *KeeperTestSuite) TestAddCollectedFees() {
 	require.Equal(suite.T(), coins1, mintCoins)
 
 }
+
+func (suite *KeeperTestSuite) TestValidateMintDenom() {
+	require.NoError(suite.T(), suite.app.MintKeeper.ValidateMintDenom(suite.ctx, sdk.DefaultBondDenom))
+	require.Error(suite.T(), suite.app.MintKeeper.ValidateMintDenom(suite.ctx, "unknown"))
+}
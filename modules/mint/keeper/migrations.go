@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+var (
+	// the param keys of the single mint denom, replaced by the schedules
+	legacyKeyInflation = []byte("Inflation")
+	legacyKeyMintDenom = []byte("MintDenom")
+)
+
+// LegacyMinter is the single minter stored under the minter key before the mint schedules
+type LegacyMinter struct {
+	LastUpdate    *gogotypes.Timestamp `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3"`
+	InflationBase string               `protobuf:"bytes,2,opt,name=inflation_base,json=inflationBase,proto3"`
+}
+
+func (m *LegacyMinter) Reset()         { *m = LegacyMinter{} }
+func (m *LegacyMinter) String() string { return proto.CompactTextString(m) }
+func (*LegacyMinter) ProtoMessage()    {}
+
+// MigrateSchedules migrates the single minter and the inflation and mint denom params
+// to the schedule and the minter of the mint denom. It is a no-op if there is no legacy minter
+func (k Keeper) MigrateSchedules(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MinterKey)
+	if bz == nil {
+		return nil
+	}
+
+	var minter LegacyMinter
+	if err := proto.Unmarshal(bz, &minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "invalid legacy minter: %s", err)
+	}
+	lastUpdate := time.Unix(0, 0).UTC()
+	if minter.LastUpdate != nil {
+		t, err := gogotypes.TimestampFromProto(minter.LastUpdate)
+		if err != nil {
+			return err
+		}
+		lastUpdate = t
+	}
+	inflationBase, ok := sdk.NewIntFromString(minter.InflationBase)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidMintInflationBase, "invalid legacy inflation base: %s", minter.InflationBase)
+	}
+
+	// the legacy params are stored as JSON, the keys are left unused as the subspace can't delete them
+	var inflation sdk.Dec
+	if err := json.Unmarshal(k.paramSpace.GetRaw(ctx, legacyKeyInflation), &inflation); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidMintInflation, "invalid legacy inflation: %s", err)
+	}
+	var denom string
+	if err := json.Unmarshal(k.paramSpace.GetRaw(ctx, legacyKeyMintDenom), &denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidMintDenom, "invalid legacy mint denom: %s", err)
+	}
+
+	params := types.NewParams(types.MintSchedule{
+		Denom:         denom,
+		Inflation:     inflation,
+		InflationBase: inflationBase,
+	})
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.ValidateMintDenom(ctx, denom); err != nil {
+		return err
+	}
+
+	store.Delete(types.MinterKey)
+	k.SetMinter(ctx, types.NewMinter(denom, lastUpdate))
+	k.SetParamSet(ctx, params)
+	return nil
+}
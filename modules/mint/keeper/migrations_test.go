@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/mint/keeper"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrateSchedules() {
+	// nothing to migrate on a chain started with the schedules
+	suite.NoError(suite.app.MintKeeper.MigrateSchedules(suite.ctx))
+	suite.Equal(types.DefaultParams(), suite.app.MintKeeper.GetParamSet(suite.ctx))
+
+	denom := types.DefaultParams().Schedules[0].Denom
+	lastUpdate := time.Date(2021, 2, 9, 0, 0, 0, 0, time.UTC)
+
+	// restore the legacy state
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, minter := range suite.app.MintKeeper.GetMinters(suite.ctx) {
+		store.Delete(types.GetMinterKey(minter.Denom))
+	}
+	timestamp, err := gogotypes.TimestampProto(lastUpdate)
+	suite.NoError(err)
+	bz, err := proto.Marshal(&keeper.LegacyMinter{LastUpdate: timestamp, InflationBase: "2000000000000000"})
+	suite.NoError(err)
+	store.Set(types.MinterKey, bz)
+
+	paramStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.DefaultParamSpace+"/"))
+	paramStore.Set([]byte("Inflation"), []byte(`"0.050000000000000000"`))
+	paramStore.Set([]byte("MintDenom"), []byte(`"`+denom+`"`))
+
+	suite.NoError(suite.app.MintKeeper.MigrateSchedules(suite.ctx))
+
+	suite.Equal([]types.Minter{types.NewMinter(denom, lastUpdate)}, suite.app.MintKeeper.GetMinters(suite.ctx))
+	suite.Equal(
+		types.NewParams(types.MintSchedule{
+			Denom:         denom,
+			Inflation:     sdk.NewDecWithPrec(5, 2),
+			InflationBase: sdk.NewInt(2000000000000000),
+		}),
+		suite.app.MintKeeper.GetParamSet(suite.ctx),
+	)
+
+	// the migration is run once
+	suite.NoError(suite.app.MintKeeper.MigrateSchedules(suite.ctx))
+}
@@ -15,7 +15,7 @@ import (
 func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key, types.MinterKey):
+		case len(kvA.Key) > 0 && bytes.Equal(kvA.Key[:1], types.MinterKey):
 			var minterA, minterB types.Minter
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &minterA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &minterB)
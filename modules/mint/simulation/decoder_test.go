@@ -16,14 +16,15 @@ import (
 )
 
 func TestDecodeStore(t *testing.T) {
-	minter := types.NewMinter(time.Now().UTC(), sdk.NewIntWithDecimal(2, 9))
+	minter := types.NewMinter(sdk.DefaultBondDenom, time.Now().UTC())
 	cdc, _ := simapp.MakeCodecs()
 	dec := simulation.NewDecodeStore(cdc)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
-			{Key: types.MinterKey, Value: cdc.MustMarshalBinaryBare(&minter)},
+			{Key: types.GetMinterKey(minter.Denom), Value: cdc.MustMarshalBinaryBare(&minter)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
+			{Key: []byte{}, Value: []byte{0x99}},
 		},
 	}
 	tests := []struct {
@@ -32,13 +33,14 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
 		{"other", ""},
+		{"empty key", ""},
 	}
 
 	for i, tt := range tests {
 		i, tt := i, tt
 		t.Run(tt.name, func(t *testing.T) {
 			switch i {
-			case len(tests) - 1:
+			case len(tests) - 2, len(tests) - 1:
 				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
 			default:
 				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
@@ -32,8 +32,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { inflation = GenInflation(r) },
 	)
 
-	params := types.Params{Inflation: inflation, MintDenom: types.MintDenom}
-	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params)
+	params := types.DefaultParams()
+	params.Schedules[0].Inflation = inflation
+	mintGenesis := types.NewGenesisState(types.DefaultMinters(), params)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
 	if err != nil {
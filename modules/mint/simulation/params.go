@@ -18,9 +18,13 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
 		simulation.NewSimParamChange(
 			types.ModuleName,
-			string(types.KeyInflation),
+			string(types.KeySchedules),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenInflation(r))
+				schedule := types.DefaultParams().Schedules[0]
+				return fmt.Sprintf(
+					"[{\"denom\":\"%s\",\"inflation\":\"%s\",\"inflation_base\":\"%s\"}]",
+					schedule.Denom, GenInflation(r), schedule.InflationBase,
+				)
 			},
 		),
 	}
@@ -6,6 +6,8 @@ import (
 
 // mint module sentinel errors
 var (
-	ErrInvalidMintInflation = sdkerrors.Register(ModuleName, 2, "invalid mint inflation")
-	ErrInvalidMintDenom     = sdkerrors.Register(ModuleName, 3, "invalid mint denom")
+	ErrInvalidMintInflation     = sdkerrors.Register(ModuleName, 2, "invalid mint inflation")
+	ErrInvalidMintDenom         = sdkerrors.Register(ModuleName, 3, "invalid mint denom")
+	ErrInvalidMintInflationBase = sdkerrors.Register(ModuleName, 4, "invalid mint inflation base")
+	ErrInvalidRecipient         = sdkerrors.Register(ModuleName, 5, "invalid mint recipient")
 )
@@ -8,4 +8,6 @@ const (
 	AttributeKeyLastInflationTime = "last_inflation_time"
 	AttributeKeyInflationTime     = "inflation_time"
 	AttributeKeyMintCoin          = "mint_coin"
+	AttributeKeyMintDenom         = "mint_denom"
+	AttributeKeyRecipient         = "recipient"
 )
@@ -3,6 +3,8 @@ package types // noalias
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
 )
 
 // accountKeeper defines the contract required for account APIs.
@@ -21,3 +23,13 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error)
+}
+
+// MintHooks event hooks for mint object (noalias)
+type MintHooks interface {
+	AfterMint(ctx sdk.Context, coins sdk.Coins) // Must be called when new coins are sent to the fee collector
+}
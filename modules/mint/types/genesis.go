@@ -1,18 +1,22 @@
 package types
 
+import (
+	"fmt"
+)
+
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(minter Minter, params Params) *GenesisState {
+func NewGenesisState(minters []Minter, params Params) *GenesisState {
 	return &GenesisState{
-		Minter: minter,
-		Params: params,
+		Minters: minters,
+		Params:  params,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultMinter(),
-		Params: DefaultParams(),
+		Minters: DefaultMinters(),
+		Params:  DefaultParams(),
 	}
 }
 
@@ -22,5 +26,19 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
-	return ValidateMinter(data.Minter)
+
+	seenDenoms := make(map[string]bool)
+	for _, minter := range data.Minters {
+		if err := ValidateMinter(minter); err != nil {
+			return err
+		}
+		if seenDenoms[minter.Denom] {
+			return fmt.Errorf("duplicate minter for denom %s", minter.Denom)
+		}
+		if _, found := data.Params.GetSchedule(minter.Denom); !found {
+			return fmt.Errorf("no mint schedule found for minter %s", minter.Denom)
+		}
+		seenDenoms[minter.Denom] = true
+	}
+	return nil
 }
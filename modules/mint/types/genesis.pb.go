@@ -25,8 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the mint module's genesis state
 type GenesisState struct {
-	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Minters []Minter `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters"`
+	Params  Params   `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -62,11 +62,11 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func (m *GenesisState) GetMinter() Minter {
+func (m *GenesisState) GetMinters() []Minter {
 	if m != nil {
-		return m.Minter
+		return m.Minters
 	}
-	return Minter{}
+	return nil
 }
 
 func (m *GenesisState) GetParams() Params {
@@ -83,20 +83,21 @@ func init() {
 func init() { proto.RegisterFile("mint/genesis.proto", fileDescriptor_50813f2cd53c1776) }
 
 var fileDescriptor_50813f2cd53c1776 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 209 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0xcd, 0xcc, 0x2b,
 	0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
 	0xc9, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x03, 0xc9, 0x49, 0xf1, 0x83, 0x55, 0x80, 0x08,
-	0x88, 0xb4, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xca,
-	0xb8, 0x78, 0xdc, 0x21, 0xa6, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x19, 0x71, 0xb1, 0x81, 0xf4,
-	0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0x9b, 0xaa, 0xe7, 0x0b,
-	0x96, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x12, 0xa4, 0xa7, 0x20, 0xb1, 0x28,
-	0x31, 0xb7, 0x58, 0x82, 0x09, 0x9b, 0x9e, 0x00, 0xb0, 0x1c, 0x4c, 0x0f, 0x44, 0xa5, 0x93, 0xfb,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x80, 0xf4,
-	0x26, 0xe7, 0xe7, 0xea, 0x83, 0xcc, 0xc9, 0x4b, 0x2d, 0xd1, 0x87, 0x9a, 0xa7, 0x9f, 0x9b, 0x9f,
-	0x52, 0x9a, 0x93, 0x5a, 0x0c, 0xf6, 0x96, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x1f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x26, 0x3f, 0xb8, 0x15, 0x12, 0x01, 0x00, 0x00,
+	0x88, 0xb4, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x2a,
+	0xb8, 0x78, 0xdc, 0x21, 0xa6, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x70, 0xb1, 0x83, 0xf4,
+	0xa4, 0x16, 0x15, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0x1b, 0xab, 0xe7,
+	0x0b, 0x96, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xa6, 0x54, 0xc8, 0x88, 0x8b, 0xad,
+	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x49, 0x81, 0x11, 0x53, 0x53, 0x00, 0x58, 0x0e, 0xaa,
+	0x09, 0xaa, 0xd2, 0xc9, 0xfd, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
+	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74,
+	0xd3, 0x33, 0x4b, 0x40, 0x7a, 0x93, 0xf3, 0x73, 0xf5, 0x41, 0xe6, 0xe4, 0xa5, 0x96, 0xe8, 0x43,
+	0xcd, 0xd3, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x7b, 0x4c, 0xbf, 0xa4, 0xb2, 0x20,
+	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x13, 0x63, 0xc0, 0x00, 0x82, 0x2e, 0x52, 0x1f, 0x14, 0x01, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,16 +130,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -159,8 +164,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = m.Minter.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	return n
@@ -203,7 +212,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -230,7 +239,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
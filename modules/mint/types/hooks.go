@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ MintHooks = MultiMintHooks{}
+
+// MultiMintHooks combines multiple mint hooks, all hook functions are run in array sequence
+type MultiMintHooks []MintHooks
+
+// NewMultiMintHooks creates a new MultiMintHooks
+func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
+	return hooks
+}
+
+// AfterMint implements MintHooks
+func (h MultiMintHooks) AfterMint(ctx sdk.Context, coins sdk.Coins) {
+	for i := range h {
+		h[i].AfterMint(ctx, coins)
+	}
+}
@@ -21,5 +21,10 @@ const (
 
 var (
 	// use for the keeper store
-	MinterKey = []byte{0x00}
+	MinterKey = []byte{0x00} // prefix for the minter of each denom
 )
+
+// GetMinterKey returns the key of the minter for the given denom
+func GetMinterKey(denom string) []byte {
+	return append(MinterKey, []byte(denom)...)
+}
@@ -28,12 +28,12 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Minter represents the minting state
+// Minter represents the minting state of a denom
 type Minter struct {
+	// denom of the coin being minted
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	// time which the last update was made to the minter
-	LastUpdate time.Time `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3,stdtime" json:"last_update" yaml:"last_update"`
-	// base inflation
-	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	LastUpdate time.Time `protobuf:"bytes,2,opt,name=last_update,json=lastUpdate,proto3,stdtime" json:"last_update" yaml:"last_update"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -69,6 +69,13 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func (m *Minter) GetLastUpdate() time.Time {
 	if m != nil {
 		return m.LastUpdate
@@ -76,18 +83,75 @@ func (m *Minter) GetLastUpdate() time.Time {
 	return time.Time{}
 }
 
-// Params defines mint module's parameters
-type Params struct {
+// MintSchedule defines the issuance schedule of a denom
+type MintSchedule struct {
 	// type of coin to mint
-	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	// inflation rate
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// base inflation
+	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	// account receiving the minted coins, the fee collector if empty
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MintSchedule) Reset()         { *m = MintSchedule{} }
+func (m *MintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintSchedule) ProtoMessage()    {}
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{1}
+}
+func (m *MintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSchedule.Merge(m, src)
+}
+func (m *MintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSchedule proto.InternalMessageInfo
+
+func (m *MintSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// Params defines mint module's parameters
+type Params struct {
+	// issuance schedules, one per minted denom
+	Schedules []MintSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1b9fbb701b2a577, []int{1}
+	return fileDescriptor_e1b9fbb701b2a577, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,45 +180,49 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetMintDenom() string {
+func (m *Params) GetSchedules() []MintSchedule {
 	if m != nil {
-		return m.MintDenom
+		return m.Schedules
 	}
-	return ""
+	return nil
 }
 
 func init() {
 	proto.RegisterType((*Minter)(nil), "irishub.mint.Minter")
+	proto.RegisterType((*MintSchedule)(nil), "irishub.mint.MintSchedule")
 	proto.RegisterType((*Params)(nil), "irishub.mint.Params")
 }
 
 func init() { proto.RegisterFile("mint/mint.proto", fileDescriptor_e1b9fbb701b2a577) }
 
 var fileDescriptor_e1b9fbb701b2a577 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x33, 0x97, 0x4b, 0x21, 0xd3, 0x7b, 0x15, 0x82, 0x42, 0x29, 0x98, 0x94, 0x2c, 0xa4,
-	0x9b, 0xce, 0x80, 0xee, 0xba, 0x0c, 0x85, 0x22, 0x28, 0x48, 0xd0, 0x8d, 0x2e, 0xca, 0xa4, 0x99,
-	0xc6, 0xc1, 0xcc, 0x4c, 0xc8, 0x4c, 0x16, 0xc5, 0x97, 0xe8, 0xd2, 0xa5, 0x8f, 0xd3, 0x65, 0x37,
-	0x82, 0xb8, 0x88, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x26, 0x69, 0x6b, 0x5d, 0xba, 0x49, 0xe6, 0xfc,
-	0x73, 0xce, 0x37, 0xe7, 0x9c, 0x1f, 0x1e, 0x72, 0x26, 0x34, 0x36, 0x1f, 0x94, 0xe5, 0x52, 0x4b,
-	0xe7, 0x1f, 0xcb, 0x99, 0x7a, 0x28, 0x22, 0x64, 0xb4, 0xf6, 0x51, 0x22, 0x13, 0x59, 0x5d, 0x60,
-	0x73, 0xaa, 0x73, 0xda, 0x5e, 0x22, 0x65, 0x92, 0x52, 0x5c, 0x45, 0x51, 0x31, 0xc1, 0x9a, 0x71,
-	0xaa, 0x34, 0xe1, 0x59, 0x9d, 0xe0, 0xbf, 0x02, 0xd8, 0xb8, 0x62, 0x42, 0xd3, 0xdc, 0xb9, 0x87,
-	0xcd, 0x94, 0x28, 0x3d, 0x2a, 0xb2, 0x98, 0x68, 0xda, 0x02, 0x1d, 0xd0, 0x6d, 0x9e, 0xb5, 0x51,
-	0x4d, 0x40, 0x5b, 0x02, 0xba, 0xd9, 0x12, 0x02, 0x77, 0x5e, 0x7a, 0xd6, 0xba, 0xf4, 0x9c, 0x29,
-	0xe1, 0x69, 0xdf, 0xdf, 0x2b, 0xf6, 0x67, 0x1f, 0x1e, 0x08, 0xa1, 0x51, 0x6e, 0x2b, 0xc1, 0x11,
-	0xf0, 0x80, 0x89, 0x49, 0x4a, 0x34, 0x93, 0x62, 0x14, 0x11, 0x45, 0x5b, 0x7f, 0x3a, 0xa0, 0x6b,
-	0x07, 0x43, 0xc3, 0x78, 0x2f, 0xbd, 0xd3, 0x84, 0x69, 0x33, 0xcb, 0x58, 0x72, 0x3c, 0x96, 0x8a,
-	0x4b, 0xb5, 0xf9, 0xf5, 0x54, 0xfc, 0x88, 0xf5, 0x34, 0xa3, 0x0a, 0x5d, 0x08, 0xbd, 0x2e, 0xbd,
-	0xe3, 0xfa, 0xb5, 0x9f, 0x34, 0x3f, 0xfc, 0xbf, 0x13, 0x02, 0x13, 0x3f, 0xc1, 0xc6, 0x35, 0xc9,
-	0x09, 0x57, 0xce, 0x09, 0x84, 0x66, 0x41, 0xa3, 0x98, 0x0a, 0xc9, 0xab, 0xa9, 0xec, 0xd0, 0x36,
-	0xca, 0xc0, 0x08, 0xce, 0x25, 0xb4, 0x77, 0x95, 0x9b, 0x9e, 0xd0, 0x2f, 0x7a, 0x1a, 0xd0, 0x71,
-	0xf8, 0x0d, 0xe8, 0xff, 0x7d, 0x7e, 0xf1, 0xac, 0x60, 0x38, 0x5f, 0xba, 0x60, 0xb1, 0x74, 0xc1,
-	0xe7, 0xd2, 0x05, 0xb3, 0x95, 0x6b, 0x2d, 0x56, 0xae, 0xf5, 0xb6, 0x72, 0xad, 0xbb, 0xde, 0x1e,
-	0xd2, 0xd8, 0x27, 0xa8, 0xc6, 0x1b, 0x1b, 0x31, 0x97, 0x71, 0x91, 0x52, 0x55, 0x59, 0x5c, 0xd3,
-	0xa3, 0x46, 0xb5, 0xf5, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x4e, 0xb8, 0x09, 0xfc,
-	0x01, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0xbb, 0x52, 0x29, 0xbe, 0x03, 0xa4, 0xe8, 0x90, 0xa2, 0x0a, 0x25, 0x55, 0x06,
+	0x74, 0xcb, 0xd9, 0x12, 0x6c, 0x1d, 0x18, 0x22, 0xa4, 0x0a, 0x09, 0x10, 0x0a, 0xb0, 0xc0, 0x50,
+	0x39, 0x89, 0x9b, 0x5a, 0xc4, 0x76, 0x14, 0x3b, 0x43, 0xc5, 0xc2, 0x47, 0xe8, 0xc8, 0xc8, 0xc7,
+	0xe9, 0xd8, 0x11, 0x31, 0x04, 0xd4, 0x7e, 0x83, 0x7e, 0x02, 0x64, 0xa7, 0x7f, 0xc2, 0xc0, 0xc0,
+	0x92, 0xe4, 0x7d, 0x62, 0xff, 0xfc, 0x3e, 0xcf, 0x6b, 0xf8, 0x90, 0x33, 0xa1, 0xb1, 0x79, 0xa0,
+	0xaa, 0x96, 0x5a, 0x7a, 0xd7, 0xac, 0x66, 0x6a, 0xd1, 0xa4, 0xc8, 0x68, 0xa3, 0x9b, 0x42, 0x16,
+	0xd2, 0xfe, 0xc0, 0xe6, 0xab, 0x5b, 0x33, 0x0a, 0x0b, 0x29, 0x8b, 0x92, 0x62, 0x5b, 0xa5, 0xcd,
+	0x1c, 0x6b, 0xc6, 0xa9, 0xd2, 0x84, 0x57, 0xdd, 0x82, 0xe8, 0x0b, 0x1c, 0xbe, 0x66, 0x42, 0xd3,
+	0xda, 0xbb, 0x81, 0xf7, 0x72, 0x2a, 0x24, 0xf7, 0xc1, 0x18, 0xdc, 0xba, 0x49, 0x57, 0x78, 0x9f,
+	0xe0, 0x55, 0x49, 0x94, 0x9e, 0x35, 0x55, 0x4e, 0x34, 0xf5, 0x2f, 0xc6, 0xe0, 0xf6, 0xea, 0xe9,
+	0x08, 0x75, 0x58, 0x74, 0xc4, 0xa2, 0xf7, 0x47, 0x6c, 0x1c, 0xac, 0xdb, 0xd0, 0xd9, 0xb7, 0xa1,
+	0xb7, 0x24, 0xbc, 0x9c, 0x44, 0xbd, 0xcd, 0xd1, 0xea, 0x57, 0x08, 0x12, 0x68, 0x94, 0x0f, 0x9d,
+	0xf0, 0xf5, 0x02, 0x5e, 0x9b, 0xd3, 0xdf, 0x65, 0x0b, 0x9a, 0x37, 0x25, 0xfd, 0x47, 0x0f, 0xaf,
+	0xa0, 0xcb, 0xc4, 0xbc, 0x24, 0x9a, 0x49, 0x61, 0x3b, 0x70, 0x63, 0x64, 0x4e, 0xf9, 0xd9, 0x86,
+	0x4f, 0x0a, 0xa6, 0x4d, 0x04, 0x99, 0xe4, 0x38, 0x93, 0x8a, 0x4b, 0x75, 0x78, 0xdd, 0xa9, 0xfc,
+	0x33, 0xd6, 0xcb, 0x8a, 0x2a, 0xf4, 0x82, 0x66, 0xc9, 0x19, 0xe0, 0x09, 0xf8, 0xe0, 0x54, 0xcc,
+	0x52, 0xa2, 0xa8, 0x7f, 0x69, 0x91, 0xd3, 0xff, 0x40, 0xbe, 0x14, 0x7a, 0xdf, 0x86, 0x8f, 0x3a,
+	0x8b, 0x7f, 0xd3, 0xa2, 0xe4, 0xfe, 0x49, 0x88, 0x89, 0xa2, 0xde, 0x63, 0xe8, 0xd6, 0x34, 0x63,
+	0x15, 0xa3, 0x42, 0xfb, 0x03, 0xeb, 0xeb, 0x2c, 0x44, 0x6f, 0xe0, 0xf0, 0x2d, 0xa9, 0x09, 0x57,
+	0xde, 0x73, 0xe8, 0xaa, 0x43, 0x0e, 0xca, 0x07, 0xe3, 0x4b, 0x9b, 0x73, 0x7f, 0xc4, 0xa8, 0x1f,
+	0x55, 0x3c, 0x30, 0xed, 0x26, 0xe7, 0x2d, 0x93, 0xc1, 0xb7, 0xef, 0xa1, 0x13, 0x4f, 0xd7, 0xdb,
+	0x00, 0x6c, 0xb6, 0x01, 0xf8, 0xbd, 0x0d, 0xc0, 0x6a, 0x17, 0x38, 0x9b, 0x5d, 0xe0, 0xfc, 0xd8,
+	0x05, 0xce, 0xc7, 0xbb, 0x9e, 0x2f, 0x83, 0x15, 0x54, 0xe3, 0x03, 0x1e, 0x73, 0x69, 0x19, 0xf6,
+	0x76, 0x75, 0x16, 0xd3, 0xa1, 0x9d, 0xed, 0xb3, 0x3f, 0x03, 0x00, 0xd5, 0xba, 0x0d, 0x3a, 0x77,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -177,16 +245,6 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.InflationBase.Size()
-		i -= size
-		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate):])
 	if err1 != nil {
 		return 0, err1
@@ -194,11 +252,18 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i -= n1
 	i = encodeVarintMint(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *MintSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -208,16 +273,33 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *MintSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.InflationBase.Size()
+		i -= size
+		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Inflation.Size()
 		i -= size
@@ -228,16 +310,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -255,25 +374,48 @@ func (m *Minter) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
 	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationBase.Size()
-	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *MintSchedule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MintDenom)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovMint(uint64(l))
 	}
 	l = m.Inflation.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationBase.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -314,9 +456,9 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -326,30 +468,29 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -359,23 +500,22 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -400,7 +540,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MintSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,15 +563,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -459,7 +599,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -495,6 +635,156 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, MintSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
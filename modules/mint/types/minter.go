@@ -14,40 +14,29 @@ const (
 var initialIssue = sdk.NewIntWithDecimal(20, 8)
 
 // Create a new minter object
-func NewMinter(lastUpdate time.Time, inflationBase sdk.Int) Minter {
+func NewMinter(denom string, lastUpdate time.Time) Minter {
 	return Minter{
-		LastUpdate:    lastUpdate,
-		InflationBase: inflationBase,
+		Denom:      denom,
+		LastUpdate: lastUpdate,
 	}
 }
 
-// DefaultMinter returns minter object for a new chain
-func DefaultMinter() Minter {
-	return NewMinter(
-		time.Unix(0, 0).UTC(),
-		initialIssue.Mul(sdk.NewIntWithDecimal(1, 6)), // 20*(10^8)iris, 20*(10^8)*(10^6)uiris
-	)
+// DefaultMinters returns the minters of the default schedules for a new chain
+func DefaultMinters() []Minter {
+	var minters []Minter
+	for _, schedule := range DefaultParams().Schedules {
+		minters = append(minters, NewMinter(schedule.Denom, time.Unix(0, 0).UTC()))
+	}
+	return minters
 }
 
 // ValidateMinter returns err if the Minter is invalid
 func ValidateMinter(m Minter) error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid minter denom (%s): %s", m.Denom, err)
+	}
 	if m.LastUpdate.Before(time.Unix(0, 0)) {
 		return fmt.Errorf("minter last update time(%s) should not be a time before January 1, 1970 UTC", m.LastUpdate.String())
 	}
-	if !m.InflationBase.GT(sdk.ZeroInt()) {
-		return fmt.Errorf("minter inflation basement (%s) should be positive", m.InflationBase.String())
-	}
 	return nil
 }
-
-// NextAnnualProvisions gets the provisions for a block based on the annual provisions rate
-func (m Minter) NextAnnualProvisions(params Params) (provisions sdk.Dec) {
-	return params.Inflation.MulInt(m.InflationBase)
-}
-
-// BlockProvision gets the provisions for a block based on the annual provisions rate
-func (m Minter) BlockProvision(params Params) sdk.Coin {
-	provisions := m.NextAnnualProvisions(params)
-	blockInflationAmount := provisions.QuoInt(sdk.NewInt(blocksPerYear))
-	return sdk.NewCoin(params.MintDenom, blockInflationAmount.TruncateInt())
-}
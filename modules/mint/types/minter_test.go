@@ -10,37 +10,52 @@ import (
 )
 
 func TestNextInflation(t *testing.T) {
-	minter := NewMinter(time.Now(), sdk.NewIntWithDecimal(100, 18))
-	tests := []struct{ params Params }{
-		{Params{Inflation: sdk.NewDecWithPrec(20, 2), MintDenom: sdk.DefaultBondDenom}},
-		{Params{Inflation: sdk.NewDecWithPrec(10, 2), MintDenom: sdk.DefaultBondDenom}},
-		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom}},
+	inflationBase := sdk.NewIntWithDecimal(100, 18)
+	tests := []struct{ schedule MintSchedule }{
+		{NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(20, 2), inflationBase, "")},
+		{NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 2), inflationBase, "")},
+		{NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 2), inflationBase, "")},
 	}
 	for _, tc := range tests {
-		annualProvisions := minter.NextAnnualProvisions(tc.params)
-		mintCoin := minter.BlockProvision(tc.params)
+		annualProvisions := tc.schedule.NextAnnualProvisions()
+		mintCoin := tc.schedule.BlockProvision()
 		blockProvision := annualProvisions.QuoInt(sdk.NewInt(12 * 60 * 8766))
 		require.True(t, mintCoin.Amount.Equal(blockProvision.TruncateInt()), "mint amount:"+mintCoin.Amount.String()+", block provision amount: "+blockProvision.TruncateInt().String())
 	}
 }
 
-func TestDefaultMinter(t *testing.T) {
-	err := ValidateMinter(DefaultMinter())
-	require.NoError(t, err)
+func TestBlockProvision(t *testing.T) {
+	inflationBase := sdk.NewIntWithDecimal(100, 18)
+	params := NewParams(
+		NewMintSchedule("uiris", sdk.NewDecWithPrec(4, 2), inflationBase, ""),
+		NewMintSchedule("uincentive", sdk.NewDecWithPrec(10, 2), inflationBase, ""),
+	)
+
+	provisions := params.BlockProvision()
+	require.Len(t, provisions, 2)
+	require.Equal(t, params.Schedules[0].BlockProvision(), sdk.NewCoin("uiris", provisions.AmountOf("uiris")))
+	require.Equal(t, params.Schedules[1].BlockProvision(), sdk.NewCoin("uincentive", provisions.AmountOf("uincentive")))
+}
+
+func TestDefaultMinters(t *testing.T) {
+	for _, minter := range DefaultMinters() {
+		err := ValidateMinter(minter)
+		require.NoError(t, err)
+	}
 }
 
 func TestMinterValidate(t *testing.T) {
 	tests := []struct {
-		expectPass    bool
-		LastUpdate    time.Time
-		InflationBase sdk.Int
+		expectPass bool
+		Denom      string
+		LastUpdate time.Time
 	}{
-		{false, time.Unix(-1, -1), initialIssue.Mul(sdk.NewIntWithDecimal(1, 18))},
-		{false, time.Unix(0, 0), initialIssue.Mul(sdk.NewIntWithDecimal(0, 0))},
-		{true, time.Unix(0, 0), initialIssue.Mul(sdk.NewIntWithDecimal(1, 18))},
+		{false, sdk.DefaultBondDenom, time.Unix(-1, -1)},
+		{false, "", time.Unix(0, 0)},
+		{true, sdk.DefaultBondDenom, time.Unix(0, 0)},
 	}
 	for i, tc := range tests {
-		minter := NewMinter(tc.LastUpdate, tc.InflationBase)
+		minter := NewMinter(tc.Denom, tc.LastUpdate)
 		err := ValidateMinter(minter)
 		if tc.expectPass {
 			require.NoError(t, err, "%d: %+v", i, err)
@@ -49,3 +64,32 @@ func TestMinterValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateGenesis(t *testing.T) {
+	inflationBase := sdk.NewIntWithDecimal(100, 18)
+	schedule := NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), inflationBase, "")
+	minter := NewMinter(sdk.DefaultBondDenom, time.Unix(0, 0))
+
+	tests := []struct {
+		name       string
+		genesis    GenesisState
+		expectPass bool
+	}{
+		{"default", *DefaultGenesisState(), true},
+		{"valid", *NewGenesisState([]Minter{minter}, NewParams(schedule)), true},
+		{"duplicate schedule", *NewGenesisState([]Minter{minter}, NewParams(schedule, schedule)), false},
+		{"duplicate minter", *NewGenesisState([]Minter{minter, minter}, NewParams(schedule)), false},
+		{"minter without schedule", *NewGenesisState([]Minter{NewMinter("uiris", time.Unix(0, 0))}, NewParams(schedule)), false},
+		{"invalid inflation", *NewGenesisState(nil, NewParams(NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(3, 1), inflationBase, ""))), false},
+		{"invalid inflation base", *NewGenesisState(nil, NewParams(NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), sdk.ZeroInt(), ""))), false},
+		{"invalid recipient", *NewGenesisState(nil, NewParams(NewMintSchedule(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), inflationBase, "invalid"))), false},
+	}
+	for _, tc := range tests {
+		err := ValidateGenesis(tc.genesis)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -1,28 +1,26 @@
 package types
 
 import (
-	"errors"
 	"fmt"
-	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
 )
 
 // default paramspace for params keeper
 const (
 	DefaultParamSpace = "mint"
-	MintDenom         = sdk.DefaultBondDenom
 )
 
 //Parameter store key
 var (
-	// params store for inflation params
-	KeyInflation = []byte("Inflation")
-	KeyMintDenom = []byte("MintDenom")
+	// params store for mint schedules
+	KeySchedules = []byte("Schedules")
 )
 
 // ParamTable for mint module
@@ -30,18 +28,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintDenom string, inflation sdk.Dec) Params {
+func NewParams(schedules ...MintSchedule) Params {
 	return Params{
-		MintDenom: mintDenom,
-		Inflation: inflation,
+		Schedules: schedules,
 	}
 }
 
 // DefaultParams returns default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		Inflation: sdk.NewDecWithPrec(4, 2),
-		MintDenom: MintDenom,
+		Schedules: []MintSchedule{
+			{
+				Denom:         tokentypes.GetNativeToken().MinUnit,
+				Inflation:     sdk.NewDecWithPrec(4, 2),
+				InflationBase: initialIssue.Mul(sdk.NewIntWithDecimal(1, 6)), // 20*(10^8)iris, 20*(10^8)*(10^6)uiris
+			},
+		},
 	}
 }
 
@@ -54,8 +56,7 @@ func (p Params) String() string {
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyInflation, &p.Inflation, validateInflation),
-		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(KeySchedules, &p.Schedules, validateSchedules),
 	}
 }
 
@@ -64,41 +65,45 @@ func (p *Params) GetParamSpace() string {
 	return DefaultParamSpace
 }
 
-// Validate returns err if the Params is invalid
-func (p Params) Validate() error {
-	if p.Inflation.GT(sdk.NewDecWithPrec(2, 1)) || p.Inflation.LT(sdk.ZeroDec()) {
-		return sdkerrors.Wrapf(ErrInvalidMintInflation, "Mint inflation [%s] should be between [0, 0.2] ", p.Inflation.String())
-	}
-	if len(p.MintDenom) == 0 {
-		return sdkerrors.Wrapf(ErrInvalidMintDenom, "Mint denom [%s] should not be empty", p.MintDenom)
+// GetSchedule returns the schedule of the given denom
+func (p Params) GetSchedule(denom string) (MintSchedule, bool) {
+	for _, schedule := range p.Schedules {
+		if schedule.Denom == denom {
+			return schedule, true
+		}
 	}
-	return nil
+	return MintSchedule{}, false
 }
 
-func validateInflation(i interface{}) error {
-	v, ok := i.(sdk.Dec)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
-	}
-
-	if v.GT(sdk.NewDecWithPrec(2, 1)) || v.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("Mint inflation [%s] should be between [0, 0.2] ", v.String())
+// BlockProvision gets the provisions of all the schedules for a block
+func (p Params) BlockProvision() sdk.Coins {
+	provisions := sdk.NewCoins()
+	for _, schedule := range p.Schedules {
+		provisions = provisions.Add(schedule.BlockProvision())
 	}
+	return provisions
+}
 
-	return nil
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	return validateSchedules(p.Schedules)
 }
 
-func validateMintDenom(i interface{}) error {
-	v, ok := i.(string)
+func validateSchedules(i interface{}) error {
+	schedules, ok := i.([]MintSchedule)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if strings.TrimSpace(v) == "" {
-		return errors.New("mint denom cannot be blank")
-	}
-	if err := sdk.ValidateDenom(v); err != nil {
-		return err
+	seenDenoms := make(map[string]bool)
+	for _, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[schedule.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidMintDenom, "duplicate mint denom [%s]", schedule.Denom)
+		}
+		seenDenoms[schedule.Denom] = true
 	}
 
 	return nil
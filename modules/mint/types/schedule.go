@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMintSchedule creates a new MintSchedule instance
+func NewMintSchedule(denom string, inflation sdk.Dec, inflationBase sdk.Int, recipient string) MintSchedule {
+	return MintSchedule{
+		Denom:         denom,
+		Inflation:     inflation,
+		InflationBase: inflationBase,
+		Recipient:     recipient,
+	}
+}
+
+// NextAnnualProvisions gets the provisions for a block based on the annual provisions rate
+func (s MintSchedule) NextAnnualProvisions() (provisions sdk.Dec) {
+	return s.Inflation.MulInt(s.InflationBase)
+}
+
+// BlockProvision gets the provisions for a block based on the annual provisions rate
+func (s MintSchedule) BlockProvision() sdk.Coin {
+	provisions := s.NextAnnualProvisions()
+	blockInflationAmount := provisions.QuoInt(sdk.NewInt(blocksPerYear))
+	return sdk.NewCoin(s.Denom, blockInflationAmount.TruncateInt())
+}
+
+// RecipientAddress returns the account receiving the minted coins, nil for the fee collector
+func (s MintSchedule) RecipientAddress() sdk.AccAddress {
+	if len(s.Recipient) == 0 {
+		return nil
+	}
+	recipient, _ := sdk.AccAddressFromBech32(s.Recipient)
+	return recipient
+}
+
+// Validate returns err if the MintSchedule is invalid
+func (s MintSchedule) Validate() error {
+	if strings.TrimSpace(s.Denom) == "" {
+		return sdkerrors.Wrapf(ErrInvalidMintDenom, "Mint denom [%s] should not be empty", s.Denom)
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintDenom, err.Error())
+	}
+	if s.Inflation.IsNil() || s.Inflation.GT(sdk.NewDecWithPrec(2, 1)) || s.Inflation.LT(sdk.ZeroDec()) {
+		return sdkerrors.Wrapf(ErrInvalidMintInflation, "Mint inflation [%s] should be between [0, 0.2] ", s.Inflation)
+	}
+	if s.InflationBase.IsNil() || !s.InflationBase.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidMintInflationBase, "Mint inflation base [%s] should be positive", s.InflationBase)
+	}
+	if len(s.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRecipient, "invalid recipient [%s]: %s", s.Recipient, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// nolint
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+)
+
+const (
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	FlagAbsoluteTimeouts       = "absolute-timeouts"
+)
+
+// common flagsets to add to various functions
+var (
+	FsTransfer = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsTransfer.String(FlagPacketTimeoutHeight, ibctransfertypes.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	FsTransfer.Uint64(FlagPacketTimeoutTimestamp, ibctransfertypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
+	FsTransfer.Bool(FlagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+)
+
+// GetQueryCmd returns the cli query commands for the nfttransfer module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nfttransfer module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryClassTrace(),
+		GetCmdQueryClassTraces(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryClassTrace implements the query class trace command.
+func GetCmdQueryClassTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-trace [class-id]",
+		Short:   "Query the class trace of an nft voucher class",
+		Example: fmt.Sprintf("%s query nfttransfer class-trace <class-id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassTrace(context.Background(), &types.QueryClassTraceRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClassTraces implements the query class traces command.
+func GetCmdQueryClassTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-traces",
+		Short:   "Query the class traces of all the nft voucher classes",
+		Example: fmt.Sprintf("%s query nfttransfer class-traces", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassTraces(context.Background(), &types.QueryClassTracesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class traces")
+	return cmd
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channelutils "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/client/utils"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+)
+
+// NewTxCmd returns the transaction commands for the nfttransfer module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "nfttransfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdTransfer(),
+	)
+	return txCmd
+}
+
+// GetCmdTransfer implements the nft transfer command.
+func GetCmdTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [src-port] [src-channel] [receiver] [class-id] [token-id]",
+		Short: "Transfer an nft through IBC",
+		Long: strings.TrimSpace(`Transfer an nft through IBC. The class id can be either the local class id or the
+full class path of a voucher class. Timeouts can be specified as absolute or relative using the "absolute-timeouts"
+flag. Relative timeouts are added to the block height and block timestamp queried from the latest consensus state
+corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
+		Example: fmt.Sprintf(
+			"%s tx nfttransfer transfer nft-transfer channel-0 <receiver> <class-id> <token-id> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcPort := args[0]
+			srcChannel := args[1]
+			classID := types.ParseClassTrace(args[3]).IBCClassID()
+
+			timeoutHeightStr, _ := cmd.Flags().GetString(FlagPacketTimeoutHeight)
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp, _ := cmd.Flags().GetUint64(FlagPacketTimeoutTimestamp)
+
+			// the relative timeouts are added to the latest consensus state of the counterparty chain
+			if absoluteTimeouts, _ := cmd.Flags().GetBool(FlagAbsoluteTimeouts); !absoluteTimeouts {
+				consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+				if err != nil {
+					return err
+				}
+
+				if !timeoutHeight.IsZero() {
+					absoluteHeight := height
+					absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
+					absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
+					timeoutHeight = absoluteHeight
+				}
+
+				if timeoutTimestamp != 0 {
+					timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+				}
+			}
+
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, classID, args[4],
+				clientCtx.GetFromAddress(), args[2],
+				timeoutHeight, timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsTransfer)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
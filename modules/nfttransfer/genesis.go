@@ -0,0 +1,44 @@
+package nfttransfer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/keeper"
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+)
+
+// InitGenesis stores genesis data and binds the nfttransfer port
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize nfttransfer genesis state: %s", err.Error()))
+	}
+
+	k.SetPort(ctx, data.PortId)
+
+	for _, trace := range data.ClassTraces {
+		k.SetClassTrace(ctx, trace)
+	}
+
+	// only bind the port if it is not bound yet, e.g. by a previous genesis import
+	if !k.IsBound(ctx, data.PortId) {
+		if err := k.BindPort(ctx, data.PortId); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var traces []types.ClassTrace
+	k.IterateClassTraces(
+		ctx,
+		func(trace types.ClassTrace) bool {
+			traces = append(traces, trace)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(k.GetPort(ctx), traces)
+}
@@ -0,0 +1,168 @@
+package nfttransfer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+	"github.com/irisnet/irishub/simapp/ibctesting"
+)
+
+const (
+	classID     = "kitty"
+	classSchema = "{}"
+	tokenID     = "kitty001"
+	tokenName   = "Kitty"
+	tokenURI    = "https://kitty.io/001"
+	tokenData   = "meow"
+)
+
+type NFTTransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestNFTTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(NFTTransferTestSuite))
+}
+
+func (suite *NFTTransferTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+// setupChannels creates the nft transfer channels between chainA and chainB
+func (suite *NFTTransferTestSuite) setupChannels() (string, string, ibctesting.TestChannel, ibctesting.TestChannel) {
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	connA.NextChannelVersion = types.Version
+	connB.NextChannelVersion = types.Version
+	channelA, channelB := suite.coordinator.CreateChannel(
+		suite.chainA, suite.chainB, connA, connB, types.PortID, types.PortID, channeltypes.UNORDERED,
+	)
+	return clientA, clientB, channelA, channelB
+}
+
+// mintNFT issues the class and mints the nft to the sender of chainA
+func (suite *NFTTransferTestSuite) mintNFT() {
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	nftKeeper := suite.chainA.App.NFTKeeper
+	suite.Require().NoError(nftKeeper.IssueDenom(ctx, classID, classID, classSchema, sender))
+	suite.Require().NoError(nftKeeper.MintNFT(ctx, classID, tokenID, tokenName, tokenURI, tokenData, sender))
+
+	suite.coordinator.CommitBlock(suite.chainA)
+}
+
+func (suite *NFTTransferTestSuite) requireOwner(chain *ibctesting.TestChain, classID string, owner sdk.AccAddress) {
+	nft, err := chain.App.NFTKeeper.GetNFT(chain.GetContext(), classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner, nft.GetOwner())
+}
+
+func (suite *NFTTransferTestSuite) TestTransferRoundTrip() {
+	clientA, clientB, channelA, channelB := suite.setupChannels()
+	suite.mintNFT()
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	senderB := suite.chainB.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransfer(channelA.PortID, channelA.ID, classID, tokenID, senderA, senderB.String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainA, suite.chainB, clientB, msg))
+
+	escrowA := types.GetEscrowAddress(channelA.PortID, channelA.ID)
+	suite.requireOwner(suite.chainA, classID, escrowA)
+
+	data := types.NewNonFungibleTokenPacketData(
+		classID, classSchema, tokenID, tokenName, tokenURI, tokenData, senderA.String(), senderB.String(),
+	)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.RelayPacket(suite.chainA, suite.chainB, clientA, clientB, packet, ack.GetBytes()))
+
+	// check the voucher on chainB
+	fullClassPath := types.GetClassPrefix(channelB.PortID, channelB.ID) + classID
+	voucherClassID := types.ParseClassTrace(fullClassPath).IBCClassID()
+
+	trace, found := suite.chainB.App.NFTTransferKeeper.GetClassTrace(suite.chainB.GetContext(), voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(fullClassPath, trace.GetFullClassPath())
+
+	denom, err := suite.chainB.App.NFTKeeper.GetDenom(suite.chainB.GetContext(), voucherClassID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fullClassPath, denom.Name)
+	suite.Require().Equal(classSchema, denom.Schema)
+	suite.Require().Equal(types.ModuleAddress.String(), denom.Creator)
+
+	voucher, err := suite.chainB.App.NFTKeeper.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(senderB, voucher.GetOwner())
+	suite.Require().Equal(tokenName, voucher.GetName())
+	suite.Require().Equal(tokenURI, voucher.GetURI())
+	suite.Require().Equal(tokenData, voucher.GetData())
+
+	// send the voucher back from chainB to chainA
+	msg = types.NewMsgTransfer(channelB.PortID, channelB.ID, voucherClassID, tokenID, senderB, senderA.String(), timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainB, suite.chainA, clientA, msg))
+
+	suite.Require().False(suite.chainB.App.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+	data = types.NewNonFungibleTokenPacketData(
+		fullClassPath, classSchema, tokenID, tokenName, tokenURI, tokenData, senderB.String(), senderA.String(),
+	)
+	packet = channeltypes.NewPacket(data.GetBytes(), 1, channelB.PortID, channelB.ID, channelA.PortID, channelA.ID, timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.RelayPacket(suite.chainB, suite.chainA, clientB, clientA, packet, ack.GetBytes()))
+
+	// the escrowed nft is released on chainA
+	suite.requireOwner(suite.chainA, classID, senderA)
+}
+
+func (suite *NFTTransferTestSuite) TestRefundOnErrorAcknowledgement() {
+	clientA, clientB, channelA, channelB := suite.setupChannels()
+	suite.mintNFT()
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+
+	// the invalid receiver fails the packet on chainB
+	msg := types.NewMsgTransfer(channelA.PortID, channelA.ID, classID, tokenID, senderA, "invalid", timeoutHeight, 0)
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainA, suite.chainB, clientB, msg))
+	suite.requireOwner(suite.chainA, classID, types.GetEscrowAddress(channelA.PortID, channelA.ID))
+
+	data := types.NewNonFungibleTokenPacketData(
+		classID, classSchema, tokenID, tokenName, tokenURI, tokenData, senderA.String(), "invalid",
+	)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, 0)
+
+	suite.Require().NoError(suite.coordinator.RecvPacket(suite.chainA, suite.chainB, clientA, packet))
+
+	_, err := sdk.AccAddressFromBech32("invalid")
+	ack := channeltypes.NewErrorAcknowledgement(err.Error()).GetBytes()
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack), suite.chainB.GetAcknowledgement(packet))
+
+	// nothing is kept on chainB
+	fullClassPath := types.GetClassPrefix(channelB.PortID, channelB.ID) + classID
+	voucherClassID := types.ParseClassTrace(fullClassPath).IBCClassID()
+	suite.Require().False(suite.chainB.App.NFTTransferKeeper.HasClassTrace(suite.chainB.GetContext(), voucherClassID))
+	suite.Require().False(suite.chainB.App.NFTKeeper.HasDenomID(suite.chainB.GetContext(), voucherClassID))
+
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, clientB, packet, ack))
+
+	// the nft is refunded on chainA
+	suite.requireOwner(suite.chainA, classID, senderA)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace implements the Query/ClassTrace gRPC method
+func (k Keeper) ClassTrace(c context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := nfttypes.ValidateDenomID(req.ClassId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid class id: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	trace, found := k.GetClassTrace(ctx, req.ClassId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "class trace of %s not found", req.ClassId)
+	}
+
+	return &types.QueryClassTraceResponse{ClassTrace: trace}, nil
+}
+
+// ClassTraces implements the Query/ClassTraces gRPC method
+func (k Keeper) ClassTraces(c context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var traces []types.ClassTrace
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var trace types.ClassTrace
+		if err := k.cdc.UnmarshalBinaryBare(value, &trace); err != nil {
+			return err
+		}
+		traces = append(traces, trace)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryClassTracesResponse{ClassTraces: traces, Pagination: pageRes}, nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+)
+
+// Keeper of the nfttransfer store
+type Keeper struct {
+	cdc           codec.Marshaler
+	storeKey      sdk.StoreKey
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	nftKeeper     types.NFTKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+}
+
+// NewKeeper returns a nfttransfer keeper
+func NewKeeper(
+	cdc codec.Marshaler,
+	key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	nftKeeper types.NFTKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		nftKeeper:     nftKeeper,
+		scopedKeeper:  scopedKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// IsBound checks if the nfttransfer module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the port and claims the port capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the port id of the nfttransfer module
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the port id of the nfttransfer module
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// GetClassTrace returns the class trace of the voucher class
+func (k Keeper) GetClassTrace(ctx sdk.Context, classID string) (types.ClassTrace, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClassTraceKey(classID))
+	if bz == nil {
+		return types.ClassTrace{}, false
+	}
+
+	var trace types.ClassTrace
+	k.cdc.MustUnmarshalBinaryBare(bz, &trace)
+	return trace, true
+}
+
+// HasClassTrace returns whether the class trace of the voucher class exists
+func (k Keeper) HasClassTrace(ctx sdk.Context, classID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetClassTraceKey(classID))
+}
+
+// SetClassTrace stores the class trace under its voucher class id
+func (k Keeper) SetClassTrace(ctx sdk.Context, trace types.ClassTrace) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&trace)
+	store.Set(types.GetClassTraceKey(trace.IBCClassID()), bz)
+}
+
+// IterateClassTraces iterates through all the class traces
+func (k Keeper) IterateClassTraces(ctx sdk.Context, op func(trace types.ClassTrace) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClassTraceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trace types.ClassTrace
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trace)
+
+		if stop := op(trace); stop {
+			break
+		}
+	}
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the nfttransfer module to claim a capability that the IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
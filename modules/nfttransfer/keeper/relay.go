@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+
+	"github.com/irisnet/irishub/modules/nfttransfer/types"
+)
+
+// SendTransfer sends the nft to the receiver on the counterparty chain. The nft
+// is escrowed if the sender chain is the source of the class, otherwise the
+// voucher is burnt. The class trace follows the same rules as the ICS-20 denom trace
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	classID,
+	tokenID string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	denom, err := k.nftKeeper.GetDenom(ctx, classID)
+	if err != nil {
+		return err
+	}
+	nft, err := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+	if err != nil {
+		return err
+	}
+
+	// the voucher classes carry their full path in the packets
+	fullClassPath := classID
+	if trace, found := k.GetClassTrace(ctx, classID); found {
+		fullClassPath = trace.GetFullClassPath()
+	}
+
+	if types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath) {
+		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		if err := k.nftKeeper.TransferOwner(
+			ctx, classID, tokenID,
+			nfttypes.DoNotModify, nfttypes.DoNotModify, nfttypes.DoNotModify,
+			sender, escrowAddress,
+		); err != nil {
+			return err
+		}
+	} else {
+		if err := k.nftKeeper.BurnNFT(ctx, classID, tokenID, sender); err != nil {
+			return err
+		}
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, denom.Schema, tokenID, nft.GetName(), nft.GetURI(), nft.GetData(),
+		sender.String(), receiver,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, packet)
+}
+
+// OnRecvPacket releases the escrowed nft to the receiver if the receiver chain is the
+// source of the class, otherwise the voucher is minted to the receiver
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// remove the prefix added by the sender chain
+		voucherPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedClassID := data.ClassId[len(voucherPrefix):]
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		return k.nftKeeper.TransferOwner(
+			ctx, classID, data.TokenId,
+			nfttypes.DoNotModify, nfttypes.DoNotModify, nfttypes.DoNotModify,
+			escrowAddress, receiver,
+		)
+	}
+
+	// the sender chain is the source, mint the voucher with the prefixed class
+	prefixedClassID := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.ClassId
+	trace := types.ParseClassTrace(prefixedClassID)
+	voucherClassID := trace.IBCClassID()
+
+	if !k.HasClassTrace(ctx, voucherClassID) {
+		k.SetClassTrace(ctx, trace)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClassTrace,
+				sdk.NewAttribute(types.AttributeKeyVoucherClassID, voucherClassID),
+				sdk.NewAttribute(types.AttributeKeyClassID, prefixedClassID),
+			),
+		)
+	}
+
+	if err := k.ensureVoucherClass(ctx, voucherClassID, prefixedClassID, data.ClassSchema); err != nil {
+		return err
+	}
+
+	return k.nftKeeper.MintNFT(
+		ctx, voucherClassID, data.TokenId, data.TokenName, data.TokenUri, data.TokenData, receiver,
+	)
+}
+
+// OnAcknowledgementPacket refunds the sender if the packet failed on the counterparty chain
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context, packet channeltypes.Packet,
+	data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement,
+) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketToken(ctx, packet, data)
+	default:
+		// the nft was escrowed or burnt when sending, nothing left to do
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was never received
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketToken(ctx, packet, data)
+}
+
+// refundPacketToken releases the escrowed nft or mints the burnt voucher back to the sender
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		return k.nftKeeper.TransferOwner(
+			ctx, classID, data.TokenId,
+			nfttypes.DoNotModify, nfttypes.DoNotModify, nfttypes.DoNotModify,
+			escrowAddress, sender,
+		)
+	}
+
+	return k.nftKeeper.MintNFT(
+		ctx, classID, data.TokenId, data.TokenName, data.TokenUri, data.TokenData, sender,
+	)
+}
+
+// ensureVoucherClass issues the voucher class owned by the module if it does not exist
+func (k Keeper) ensureVoucherClass(ctx sdk.Context, voucherClassID, fullClassPath, schema string) error {
+	if !k.nftKeeper.HasDenomID(ctx, voucherClassID) {
+		return k.nftKeeper.IssueDenom(ctx, voucherClassID, fullClassPath, schema, types.ModuleAddress)
+	}
+
+	denom, err := k.nftKeeper.GetDenom(ctx, voucherClassID)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(denom.Creator, types.ModuleAddress.String()) {
+		return sdkerrors.Wrapf(types.ErrInvalidClassID, "class %s is not issued by the %s module", voucherClassID, types.ModuleName)
+	}
+	return nil
+}
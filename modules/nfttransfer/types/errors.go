@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// nfttransfer module sentinel errors
+var (
+	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidClassID       = sdkerrors.Register(ModuleName, 3, "invalid class id")
+	ErrInvalidClassTrace    = sdkerrors.Register(ModuleName, 4, "invalid class trace")
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 5, "invalid nft transfer version")
+	ErrTraceNotFound        = sdkerrors.Register(ModuleName, 6, "class trace not found")
+	ErrMaxTransferChannels  = sdkerrors.Register(ModuleName, 7, "max nft transfer channels")
+)
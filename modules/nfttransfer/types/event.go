@@ -0,0 +1,19 @@
+// nolint
+package types
+
+// nfttransfer module event types
+const (
+	EventTypeTransfer   = "ibc_nft_transfer"
+	EventTypePacket     = "non_fungible_token_packet"
+	EventTypeTimeout    = "timeout"
+	EventTypeClassTrace = "class_trace"
+
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenID        = "token_id"
+	AttributeKeyVoucherClassID = "voucher_class_id"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAckError       = "error"
+
+	AttributeValueCategory = ModuleName
+)
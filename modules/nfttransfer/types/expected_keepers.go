@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+
+	nftexported "github.com/irisnet/irismod/modules/nft/exported"
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// NFTKeeper defines the expected nft keeper
+type NFTKeeper interface {
+	IssueDenom(ctx sdk.Context, id, name, schema string, creator sdk.AccAddress) error
+	GetDenom(ctx sdk.Context, id string) (denom nfttypes.Denom, err error)
+	HasDenomID(ctx sdk.Context, id string) bool
+	GetNFT(ctx sdk.Context, denomID, tokenID string) (nft nftexported.NFT, err error)
+	MintNFT(ctx sdk.Context, denomID, tokenID, tokenNm, tokenURI, tokenData string, owner sdk.AccAddress) error
+	TransferOwner(ctx sdk.Context, denomID, tokenID, tokenNm, tokenURI, tokenData string, srcOwner, dstOwner sdk.AccAddress) error
+	BurnNFT(ctx sdk.Context, denomID, tokenID string, owner sdk.AccAddress) error
+}
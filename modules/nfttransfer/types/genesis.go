@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(portID string, classTraces []ClassTrace) *GenesisState {
+	return &GenesisState{
+		PortId:      portID,
+		ClassTraces: classTraces,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PortId: PortID,
+	}
+}
+
+// ValidateGenesis performs basic validation of the nfttransfer genesis data
+func ValidateGenesis(data GenesisState) error {
+	if err := host.PortIdentifierValidator(data.PortId); err != nil {
+		return err
+	}
+
+	seenTraces := make(map[string]bool)
+	for i, trace := range data.ClassTraces {
+		if trace.Path == "" {
+			return fmt.Errorf("class trace %d has no path", i)
+		}
+		if err := trace.Validate(); err != nil {
+			return fmt.Errorf("invalid class trace %d: %w", i, err)
+		}
+		classID := trace.IBCClassID()
+		if seenTraces[classID] {
+			return fmt.Errorf("duplicate class trace: %s", classID)
+		}
+		seenTraces[classID] = true
+	}
+	return nil
+}
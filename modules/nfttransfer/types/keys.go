@@ -0,0 +1,58 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "nfttransfer"
+
+	// Version defines the current version the nft transfer module supports
+	Version = "ics721-1"
+
+	// PortID is the default port id that the nft transfer module binds to
+	PortID = "nft-transfer"
+
+	// StoreKey is the default store key for nfttransfer
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nfttransfer
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the nfttransfer store.
+	QuerierRoute = StoreKey
+
+	// ClassPrefix is the prefix of the class ids of the nft vouchers
+	ClassPrefix = "ibc"
+)
+
+var (
+	PortKey       = []byte{0x01} // key for the port id
+	ClassTraceKey = []byte{0x02} // class trace key prefix
+	ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+)
+
+// GetClassTraceKey returns the key of the class trace of the voucher class
+func GetClassTraceKey(classID string) []byte {
+	return append(ClassTraceKey, []byte(classID)...)
+}
+
+// GetEscrowAddress returns the escrow address of the nfts sent through the specified channel.
+// It is derived the same way as the ICS-20 escrow address with the nft transfer version
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	// a slash is used to create domain separation between port and channel identifiers to
+	// prevent address collisions between escrow addresses created for different channels
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
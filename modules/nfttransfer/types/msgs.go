@@ -0,0 +1,89 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+)
+
+const (
+	TypeMsgTransfer = "transfer" // type for MsgTransfer
+)
+
+var (
+	_ sdk.Msg = &MsgTransfer{}
+)
+
+// NewMsgTransfer constructs a MsgTransfer
+func NewMsgTransfer(
+	sourcePort, sourceChannel, classID, tokenID string,
+	sender sdk.AccAddress, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassId:          classID,
+		TokenId:          tokenID,
+		Sender:           sender.String(),
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgTransfer) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgTransfer) Type() string { return TypeMsgTransfer }
+
+// GetSignBytes implements Msg.
+func (msg MsgTransfer) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if err := nfttypes.ValidateDenomID(msg.ClassId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidClassID, err.Error())
+	}
+	if err := nfttypes.ValidateTokenID(msg.TokenId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "timeout height and timeout timestamp cannot both be 0")
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+)
+
+// NewNonFungibleTokenPacketData contructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classSchema, tokenID, tokenName, tokenURI, tokenData string,
+	sender, receiver string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:     classID,
+		ClassSchema: classSchema,
+		TokenId:     tokenID,
+		TokenName:   tokenName,
+		TokenUri:    tokenURI,
+		TokenData:   tokenData,
+		Sender:      sender,
+		Receiver:    receiver,
+	}
+}
+
+// ValidateBasic is used for validating the nft transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if err := nfttypes.ValidateTokenID(nftpd.TokenId); err != nil {
+		return err
+	}
+	if err := nfttypes.ValidateTokenURI(nftpd.TokenUri); err != nil {
+		return err
+	}
+	if err := ParseClassTrace(nftpd.ClassId).Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidClassTrace, err.Error())
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(PacketCdc.MustMarshalJSON(&nftpd))
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// classHashLen is the length of the hash in the class ids of the nft vouchers,
+// it keeps the voucher class ids within the max length of the nft denom ids
+const classHashLen = 61
+
+// ParseClassTrace parses a string with the ibc prefix (class trace) and the base class id
+// into a ClassTrace type.
+//
+// Examples:
+//
+//   - "nft-transfer/channelidone/kitty" => ClassTrace{Path: "nft-transfer/channelidone", BaseClassId: "kitty"}
+//   - "kitty" => ClassTrace{Path: "", BaseClassId: "kitty"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	classSplit := strings.Split(rawClassID, "/")
+
+	if classSplit[0] == rawClassID {
+		return ClassTrace{
+			Path:        "",
+			BaseClassId: rawClassID,
+		}
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(classSplit[:len(classSplit)-1], "/"),
+		BaseClassId: classSplit[len(classSplit)-1],
+	}
+}
+
+// GetFullClassPath returns the full class path according to the ICS-721 specification:
+// tracePath + "/" + baseClassId
+// If there exists no trace then the base class id is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.Path + "/" + ct.BaseClassId
+}
+
+// IBCClassID returns the local class id of the nft vouchers of the trace. It is the
+// prefix "ibc" followed by the truncated lowercase hex hash of the full class path,
+// so that it is a valid nft denom id. The base class id is returned if there is no trace
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return ClassPrefix + hex.EncodeToString(hash[:])[:classHashLen]
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	// empty trace is accepted when the class lives on the original chain
+	switch {
+	case ct.Path == "" && ct.BaseClassId != "":
+		return nil
+	case strings.TrimSpace(ct.BaseClassId) == "":
+		return fmt.Errorf("base class id cannot be blank")
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+
+	// validate correctness of port and channel identifiers
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// GetClassPrefix returns the receiving class prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// SenderChainIsSource returns false if the nft class originally came
+// from the receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the nft class originally came
+// from the receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// the class id has the sender's source port and channel as the prefix if the
+	// receiver chain originally sent the nft to the sender chain
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	tests := []struct {
+		name     string
+		classID  string
+		expTrace ClassTrace
+	}{
+		{"empty class", "", ClassTrace{}},
+		{"base class", "kitty", ClassTrace{BaseClassId: "kitty"}},
+		{"trace info", "nft-transfer/channel-1/kitty", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channel-1"}},
+		{"incomplete path", "nft-transfer/kitty", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer"}},
+		{"multiple hops", "nft-transfer/channel-1/nft-transfer/channel-2/kitty", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channel-1/nft-transfer/channel-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trace := ParseClassTrace(tt.classID)
+			require.Equal(t, tt.expTrace, trace)
+			if tt.classID != "" {
+				require.Equal(t, tt.classID, trace.GetFullClassPath())
+			}
+		})
+	}
+}
+
+func TestClassTraceValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		trace   ClassTrace
+		wantErr bool
+	}{
+		{"base class", ClassTrace{BaseClassId: "kitty"}, false},
+		{"valid trace", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channel-1"}, false},
+		{"empty base class", ClassTrace{Path: "nft-transfer/channel-1"}, true},
+		{"incomplete path", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer"}, true},
+		{"invalid port", ClassTrace{BaseClassId: "kitty", Path: "a/channel-1"}, true},
+		{"invalid channel", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/a"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.trace.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIBCClassID(t *testing.T) {
+	require.Equal(t, "kitty", ClassTrace{BaseClassId: "kitty"}.IBCClassID())
+
+	trace := ParseClassTrace("nft-transfer/channel-1/nft-transfer/channel-2/kitty")
+	classID := trace.IBCClassID()
+	require.NoError(t, nfttypes.ValidateDenomID(classID))
+	require.Equal(t, nfttypes.MaxDenomLen, len(classID))
+	require.NotEqual(t, classID, ParseClassTrace("nft-transfer/channel-1/kitty").IBCClassID())
+}
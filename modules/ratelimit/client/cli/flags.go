@@ -0,0 +1,23 @@
+// nolint
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagMaxOutflow = "max-outflow"
+	FlagMaxInflow  = "max-inflow"
+	FlagPeriod     = "period"
+)
+
+// common flagsets to add to various functions
+var (
+	FsSetQuota = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsSetQuota.String(FlagMaxOutflow, "0", "Maximum amount sent through the channel per period, 0 means unlimited")
+	FsSetQuota.String(FlagMaxInflow, "0", "Maximum amount received through the channel per period, 0 means unlimited")
+	FsSetQuota.Duration(FlagPeriod, 0, "Length of the period, e.g. 24h")
+}
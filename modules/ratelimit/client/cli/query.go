@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+// GetQueryCmd returns the cli query commands for the ratelimit module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ratelimit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryQuota(),
+		GetCmdQueryQuotas(),
+		GetCmdQueryFlows(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryQuota implements the query quota command.
+func GetCmdQueryQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quota [channel-id] [denom]",
+		Short:   "Query the transfer quota of a denom on a channel and the flow of the current period",
+		Example: fmt.Sprintf("%s query ratelimit quota channel-0 uiris", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateQuotaID(args[0], args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quota(context.Background(), &types.QueryQuotaRequest{ChannelId: args[0], Denom: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryQuotas implements the query quotas command.
+func GetCmdQueryQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quotas",
+		Short:   "Query all the transfer quotas",
+		Example: fmt.Sprintf("%s query ratelimit quotas", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quotas(context.Background(), &types.QueryQuotasRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quotas")
+	return cmd
+}
+
+// GetCmdQueryFlows implements the query flows command.
+func GetCmdQueryFlows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "flows",
+		Short:   "Query the recorded transfer flows",
+		Long:    "Query the recorded transfer flows, a flow whose period has ended is reset by the next transfer.",
+		Example: fmt.Sprintf("%s query ratelimit flows", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Flows(context.Background(), &types.QueryFlowsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "flows")
+	return cmd
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+// NewTxCmd returns the transaction commands for the ratelimit module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ratelimit transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSetQuota(),
+		GetCmdDeleteQuota(),
+	)
+	return txCmd
+}
+
+// GetCmdSetQuota implements the set quota command.
+func GetCmdSetQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-quota [channel-id] [denom]",
+		Short: "Set the transfer quota of a denom on a channel",
+		Long:  "Set the maximum amount of a denom sent and received through an IBC channel per period, the flow of the current period is reset. Only guardian supers can set quotas.",
+		Example: fmt.Sprintf(
+			"%s tx ratelimit set-quota channel-0 uiris --max-outflow=1000000000 --max-inflow=1000000000 --period=24h --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxOutflowStr, _ := cmd.Flags().GetString(FlagMaxOutflow)
+			maxOutflow, ok := sdk.NewIntFromString(maxOutflowStr)
+			if !ok {
+				return fmt.Errorf("invalid max outflow: %s", maxOutflowStr)
+			}
+
+			maxInflowStr, _ := cmd.Flags().GetString(FlagMaxInflow)
+			maxInflow, ok := sdk.NewIntFromString(maxInflowStr)
+			if !ok {
+				return fmt.Errorf("invalid max inflow: %s", maxInflowStr)
+			}
+
+			period, _ := cmd.Flags().GetDuration(FlagPeriod)
+
+			quota := types.NewQuota(args[0], args[1], maxOutflow, maxInflow, period)
+			msg := types.NewMsgSetQuota(quota, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSetQuota)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeleteQuota implements the delete quota command.
+func GetCmdDeleteQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-quota [channel-id] [denom]",
+		Short: "Delete the transfer quota of a denom on a channel",
+		Long:  "Delete the transfer quota of a denom on an IBC channel and its flow. Only guardian supers can delete quotas.",
+		Example: fmt.Sprintf(
+			"%s tx ratelimit delete-quota channel-0 uiris --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteQuota(args[0], args[1], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSubmitQuotaProposalTxCmd returns a CLI command handler for creating
+// a ratelimit quota proposal governance transaction.
+func NewSubmitQuotaProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratelimit-quota [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a ratelimit quota proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set and delete IBC transfer quotas along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal ratelimit-quota <path/to/proposal.json> --deposit=1000iris --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Limit IRIS transfers",
+  "description": "Limit the IRIS sent and received through channel-0 to 1000000 IRIS a day",
+  "set_quotas": [
+    {
+      "channel_id": "channel-0",
+      "denom": "uiris",
+      "max_outflow": "1000000000000",
+      "max_inflow": "1000000000000",
+      "period": "86400s"
+    }
+  ],
+  "delete_quotas": [
+    {
+      "channel_id": "channel-1",
+      "denom": "uiris"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.QuotaProposal
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	return cmd
+}
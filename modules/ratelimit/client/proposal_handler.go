@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/ratelimit/client/cli"
+	"github.com/irisnet/irishub/modules/ratelimit/client/rest"
+)
+
+// ProposalHandler is the ratelimit quota proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewSubmitQuotaProposalTxCmd, rest.ProposalRESTHandler)
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/ratelimit/keeper"
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize ratelimit genesis state: %s", err.Error()))
+	}
+
+	for _, quota := range data.Quotas {
+		k.SetQuota(ctx, quota)
+	}
+	for _, flow := range data.Flows {
+		k.SetFlow(ctx, flow)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var quotas []types.Quota
+	k.IterateQuotas(
+		ctx,
+		func(quota types.Quota) bool {
+			quotas = append(quotas, quota)
+			return false
+		},
+	)
+
+	var flows []types.Flow
+	k.IterateFlows(
+		ctx,
+		func(flow types.Flow) bool {
+			flows = append(flows, flow)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(quotas, flows)
+}
@@ -0,0 +1,168 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+
+	"github.com/irisnet/irishub/modules/ratelimit/keeper"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule wraps the ICS-20 transfer module to enforce the inflow quotas on
+// the received packets and to revert the outflow of the failed transfers
+type IBCModule struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule wrapping the given transfer module
+func NewIBCModule(app porttypes.IBCModule, k keeper.Keeper) IBCModule {
+	return IBCModule{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyVersion string) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The packet is acknowledged with
+// an error without being passed to the transfer module if it exceeds the inflow quota
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, []byte, error) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	denom := ReceivedDenom(packet, data.Denom)
+	amount := sdk.NewIntFromUint64(data.Amount)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := im.keeper.CheckAndAddInflow(cacheCtx, packet.DestinationChannel, denom, amount); err != nil {
+		ack := channeltypes.NewErrorAcknowledgement(err.Error())
+		return &sdk.Result{}, ack.GetBytes(), nil
+	}
+
+	res, ackBz, err := im.app.OnRecvPacket(ctx, packet)
+	if err != nil {
+		return res, ackBz, err
+	}
+
+	// only the successfully received tokens are counted
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack); err == nil {
+		if _, ok := ack.Response.(*channeltypes.Acknowledgement_Result); ok {
+			writeCache()
+		}
+	}
+
+	return res, ackBz, nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The outflow of the
+// refunded tokens is reverted if the packet is acknowledged with an error, and is kept otherwise
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) (*sdk.Result, error) {
+	res, err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	if err != nil {
+		return res, err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		if _, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+			im.revertOutflow(ctx, packet)
+			return res, nil
+		}
+	}
+
+	im.keeper.DeleteSentPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	return res, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The outflow of the refunded tokens is reverted
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+	res, err := im.app.OnTimeoutPacket(ctx, packet)
+	if err != nil {
+		return res, err
+	}
+
+	im.revertOutflow(ctx, packet)
+	return res, nil
+}
+
+func (im IBCModule) revertOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		im.keeper.DeleteSentPacket(ctx, packet.SourceChannel, packet.Sequence)
+		return
+	}
+
+	// the packet denom is the full path of the sent tokens, which is hashed locally
+	denom := ibctransfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	im.keeper.RevertOutflow(ctx, packet.SourceChannel, packet.Sequence, denom, sdk.NewIntFromUint64(data.Amount))
+}
+
+// ReceivedDenom returns the local denom of the tokens received by the packet
+func ReceivedDenom(packet channeltypes.Packet, packetDenom string) string {
+	if ibctransfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, packetDenom) {
+		// the tokens return to this chain, remove the prefix added by the sender chain
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		return ibctransfertypes.ParseDenomTrace(packetDenom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// vouchers are minted with the prefix of the destination port and channel
+	prefixedDenom := ibctransfertypes.GetDenomPrefix(packet.DestinationPort, packet.DestinationChannel) + packetDenom
+	return ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,187 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+
+	"github.com/irisnet/irishub/modules/ratelimit"
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+// mockTransferModule acknowledges the received packets with the given acknowledgement
+type mockTransferModule struct {
+	porttypes.IBCModule
+	ack []byte
+}
+
+func (m mockTransferModule) OnRecvPacket(sdk.Context, channeltypes.Packet) (*sdk.Result, []byte, error) {
+	return &sdk.Result{}, m.ack, nil
+}
+
+func (m mockTransferModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+func (m mockTransferModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet) (*sdk.Result, error) {
+	return &sdk.Result{}, nil
+}
+
+// mockChannelKeeper sends the packets with the given error
+type mockChannelKeeper struct {
+	ibctransfertypes.ChannelKeeper
+	err error
+}
+
+func (m mockChannelKeeper) SendPacket(sdk.Context, *capabilitytypes.Capability, ibcexported.PacketI) error {
+	return m.err
+}
+
+func newTransferPacket(denom string, amount uint64) channeltypes.Packet {
+	data := ibctransfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver")
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-7", clienttypes.NewHeight(0, 100), 0,
+	)
+}
+
+func setupRateLimit() (sdk.Context, *simapp.SimApp) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	app.RateLimitKeeper.SetQuota(ctx, types.NewQuota("channel-0", "uiris", sdk.NewInt(100), sdk.NewInt(100), time.Hour))
+	return ctx, app
+}
+
+func getFlow(ctx sdk.Context, app *simapp.SimApp) types.Flow {
+	quota, _ := app.RateLimitKeeper.GetQuota(ctx, "channel-0", "uiris")
+	return app.RateLimitKeeper.GetFlow(ctx, quota)
+}
+
+func TestSendPacket(t *testing.T) {
+	ctx, app := setupRateLimit()
+
+	wrapper := ratelimit.NewICS4Wrapper(mockChannelKeeper{}, app.RateLimitKeeper)
+	require.NoError(t, wrapper.SendPacket(ctx, nil, newTransferPacket("uiris", 80)))
+	require.Equal(t, sdk.NewInt(80), getFlow(ctx, app).Outflow)
+
+	// the packets exceeding the quota are not sent
+	sendErr := errors.New("should not be sent")
+	wrapper = ratelimit.NewICS4Wrapper(mockChannelKeeper{err: sendErr}, app.RateLimitKeeper)
+	err := wrapper.SendPacket(ctx, nil, newTransferPacket("uiris", 30))
+	require.True(t, types.ErrOutflowExceeded.Is(err))
+	require.Equal(t, sdk.NewInt(80), getFlow(ctx, app).Outflow)
+
+	// the denoms without quota are not limited
+	require.Equal(t, sendErr, wrapper.SendPacket(ctx, nil, newTransferPacket("uatom", 1000)))
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	ctx, app := setupRateLimit()
+
+	// the tokens of this chain return with the prefix of the sender chain
+	packet := newTransferPacket("transfer/channel-7/uiris", 60)
+	packet.SourceChannel, packet.DestinationChannel = "channel-7", "channel-0"
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes()
+	errorAck := channeltypes.NewErrorAcknowledgement("failed").GetBytes()
+
+	// the tokens failing to be received are not counted
+	im := ratelimit.NewIBCModule(mockTransferModule{ack: errorAck}, app.RateLimitKeeper)
+	_, ack, err := im.OnRecvPacket(ctx, packet)
+	require.NoError(t, err)
+	require.Equal(t, errorAck, ack)
+	require.True(t, getFlow(ctx, app).Inflow.IsZero())
+
+	im = ratelimit.NewIBCModule(mockTransferModule{ack: successAck}, app.RateLimitKeeper)
+	_, ack, err = im.OnRecvPacket(ctx, packet)
+	require.NoError(t, err)
+	require.Equal(t, successAck, ack)
+	require.Equal(t, sdk.NewInt(60), getFlow(ctx, app).Inflow)
+
+	// the packets exceeding the quota are acknowledged with an error
+	_, ack, err = im.OnRecvPacket(ctx, packet)
+	require.NoError(t, err)
+	require.NotEqual(t, successAck, ack)
+	require.Equal(t, sdk.NewInt(60), getFlow(ctx, app).Inflow)
+}
+
+func TestOnAcknowledgementPacket(t *testing.T) {
+	ctx, app := setupRateLimit()
+	wrapper := ratelimit.NewICS4Wrapper(mockChannelKeeper{}, app.RateLimitKeeper)
+	im := ratelimit.NewIBCModule(mockTransferModule{}, app.RateLimitKeeper)
+
+	succeeded, failed := newTransferPacket("uiris", 50), newTransferPacket("uiris", 30)
+	failed.Sequence = 2
+	require.NoError(t, wrapper.SendPacket(ctx, nil, succeeded))
+	require.NoError(t, wrapper.SendPacket(ctx, nil, failed))
+
+	// the outflow is kept on success
+	_, err := im.OnAcknowledgementPacket(ctx, succeeded, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(80), getFlow(ctx, app).Outflow)
+
+	// the outflow of the refunded tokens is reverted on error
+	_, err = im.OnAcknowledgementPacket(ctx, failed, channeltypes.NewErrorAcknowledgement("failed").GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), getFlow(ctx, app).Outflow)
+}
+
+func TestOnTimeoutPacket(t *testing.T) {
+	ctx, app := setupRateLimit()
+	wrapper := ratelimit.NewICS4Wrapper(mockChannelKeeper{}, app.RateLimitKeeper)
+	im := ratelimit.NewIBCModule(mockTransferModule{}, app.RateLimitKeeper)
+
+	sent := newTransferPacket("uiris", 80)
+	require.NoError(t, wrapper.SendPacket(ctx, nil, sent))
+
+	_, err := im.OnTimeoutPacket(ctx, sent)
+	require.NoError(t, err)
+	require.True(t, getFlow(ctx, app).Outflow.IsZero())
+
+	// the packets sent in a past period do not raise the quota of the current period
+	require.NoError(t, wrapper.SendPacket(ctx, nil, sent))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	next := newTransferPacket("uiris", 100)
+	next.Sequence = 2
+	require.NoError(t, wrapper.SendPacket(ctx, nil, next))
+
+	_, err = im.OnTimeoutPacket(ctx, sent)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), getFlow(ctx, app).Outflow)
+}
+
+func TestReceivedDenom(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-7",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+
+	tests := []struct {
+		name        string
+		packetDenom string
+		expected    string
+	}{
+		{"native token returns", "transfer/channel-7/uiris", "uiris"},
+		{"voucher returns", "transfer/channel-7/transfer/channel-1/uatom", ibctransfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()},
+		{"foreign token", "uatom", ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()},
+		{"foreign voucher", "transfer/channel-3/uatom", ibctransfertypes.ParseDenomTrace("transfer/channel-0/transfer/channel-3/uatom").IBCDenom()},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, ratelimit.ReceivedDenom(packet, tt.packetDenom), tt.name)
+	}
+}
@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+
+	"github.com/irisnet/irishub/modules/ratelimit/keeper"
+)
+
+var _ ibctransfertypes.ChannelKeeper = ICS4Wrapper{}
+
+// ICS4Wrapper wraps the channel keeper of the transfer keeper to enforce the outflow quotas
+// on the sent packets. The outflow is recorded along with the packet, so that it is never
+// charged for the transfers failing before the packet is sent
+type ICS4Wrapper struct {
+	ibctransfertypes.ChannelKeeper
+	keeper keeper.Keeper
+}
+
+// NewICS4Wrapper creates a new ICS4Wrapper wrapping the given channel keeper
+func NewICS4Wrapper(ck ibctransfertypes.ChannelKeeper, k keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ChannelKeeper: ck,
+		keeper:        k,
+	}
+}
+
+// SendPacket adds the sent tokens to the outflow before sending the packet
+func (w ICS4Wrapper) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	// the packet denom is the full path of the sent tokens, which is hashed locally
+	denom := ibctransfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	if err := w.keeper.CheckAndAddOutflow(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom, sdk.NewIntFromUint64(data.Amount)); err != nil {
+		return err
+	}
+
+	return w.ChannelKeeper.SendPacket(ctx, channelCap, packet)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Quota implements the Query/Quota gRPC method
+func (k Keeper) Quota(c context.Context, req *types.QueryQuotaRequest) (*types.QueryQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateQuotaID(req.ChannelId, req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid quota id: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	quota, found := k.GetQuota(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "quota of %s on %s not found", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryQuotaResponse{Quota: quota, Flow: k.GetFlow(ctx, quota)}, nil
+}
+
+// Quotas implements the Query/Quotas gRPC method
+func (k Keeper) Quotas(c context.Context, req *types.QueryQuotasRequest) (*types.QueryQuotasResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var quotas []types.Quota
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QuotaKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var quota types.Quota
+		if err := k.cdc.UnmarshalBinaryBare(value, &quota); err != nil {
+			return err
+		}
+		quotas = append(quotas, quota)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryQuotasResponse{Quotas: quotas, Pagination: pageRes}, nil
+}
+
+// Flows implements the Query/Flows gRPC method
+func (k Keeper) Flows(c context.Context, req *types.QueryFlowsRequest) (*types.QueryFlowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var flows []types.Flow
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FlowKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var flow types.Flow
+		if err := k.cdc.UnmarshalBinaryBare(value, &flow); err != nil {
+			return err
+		}
+		flows = append(flows, flow)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFlowsResponse{Flows: flows, Pagination: pageRes}, nil
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+// Keeper of the ratelimit store
+type Keeper struct {
+	cdc            codec.Marshaler
+	storeKey       sdk.StoreKey
+	guardianKeeper types.GuardianKeeper
+}
+
+// NewKeeper returns a ratelimit keeper
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, gk types.GuardianKeeper) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		guardianKeeper: gk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// SetQuota stores the quota, the flow of the quota is reset
+func (k Keeper) SetQuota(ctx sdk.Context, quota types.Quota) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&quota)
+	store.Set(types.GetQuotaKey(quota.ChannelId, quota.Denom), bz)
+	store.Delete(types.GetFlowKey(quota.ChannelId, quota.Denom))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetQuota,
+			sdk.NewAttribute(types.AttributeKeyChannelID, quota.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, quota.Denom),
+		),
+	)
+}
+
+// DeleteQuota removes the quota and its flow
+func (k Keeper) DeleteQuota(ctx sdk.Context, channelID, denom string) error {
+	if _, found := k.GetQuota(ctx, channelID, denom); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownQuota, "quota of %s on %s not found", denom, channelID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQuotaKey(channelID, denom))
+	store.Delete(types.GetFlowKey(channelID, denom))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteQuota,
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return nil
+}
+
+// GetQuota returns the quota of the denom on the channel
+func (k Keeper) GetQuota(ctx sdk.Context, channelID, denom string) (quota types.Quota, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQuotaKey(channelID, denom))
+	if bz == nil {
+		return quota, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &quota)
+	return quota, true
+}
+
+// IterateQuotas iterates over all the quotas
+func (k Keeper) IterateQuotas(ctx sdk.Context, op func(quota types.Quota) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.QuotaKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var quota types.Quota
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &quota)
+
+		if stop := op(quota); stop {
+			break
+		}
+	}
+}
+
+// SetFlow stores the flow
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&flow)
+	store.Set(types.GetFlowKey(flow.ChannelId, flow.Denom), bz)
+}
+
+// GetFlow returns the flow of the current period of the quota, an empty flow
+// starting from the current block is returned if the last period has ended
+func (k Keeper) GetFlow(ctx sdk.Context, quota types.Quota) types.Flow {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFlowKey(quota.ChannelId, quota.Denom))
+	if bz != nil {
+		var flow types.Flow
+		k.cdc.MustUnmarshalBinaryBare(bz, &flow)
+		if ctx.BlockTime().Before(flow.PeriodEnd) {
+			return flow
+		}
+	}
+
+	return types.NewFlow(quota.ChannelId, quota.Denom, ctx.BlockTime().Add(quota.Period))
+}
+
+// IterateFlows iterates over all the stored flows
+func (k Keeper) IterateFlows(ctx sdk.Context, op func(flow types.Flow) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FlowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &flow)
+
+		if stop := op(flow); stop {
+			break
+		}
+	}
+}
+
+// CheckOutflow returns an error if adding the amount to the outflow of the denom on the channel
+// would exceed the quota of the current period, the outflow is left untouched
+func (k Keeper) CheckOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	_, _, err := k.addOutflow(ctx, channelID, denom, amount)
+	return err
+}
+
+// CheckAndAddOutflow adds the amount of the packet sent on the channel to the outflow of the denom,
+// an error is returned if the outflow of the current period exceeds the quota. The period of the
+// outflow is recorded along with the packet. It is a no-op if there is no quota of the denom on the channel
+func (k Keeper) CheckAndAddOutflow(ctx sdk.Context, channelID string, sequence uint64, denom string, amount sdk.Int) error {
+	flow, found, err := k.addOutflow(ctx, channelID, denom, amount)
+	if err != nil || !found {
+		return err
+	}
+
+	k.SetFlow(ctx, flow)
+	ctx.KVStore(k.storeKey).Set(types.GetSentPacketKey(channelID, sequence), sdk.FormatTimeBytes(flow.PeriodEnd))
+	return nil
+}
+
+// DeleteSentPacket deletes the period recorded along with the packet sent on the channel,
+// it is called once the packet is acknowledged
+func (k Keeper) DeleteSentPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSentPacketKey(channelID, sequence))
+}
+
+// addOutflow returns the flow with the amount added to the outflow, and whether there is a quota
+func (k Keeper) addOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) (types.Flow, bool, error) {
+	quota, found := k.GetQuota(ctx, channelID, denom)
+	if !found {
+		return types.Flow{}, false, nil
+	}
+
+	flow := k.GetFlow(ctx, quota)
+	flow.Outflow = flow.Outflow.Add(amount)
+	if !quota.MaxOutflow.IsZero() && flow.Outflow.GT(quota.MaxOutflow) {
+		return flow, true, sdkerrors.Wrapf(
+			types.ErrOutflowExceeded,
+			"outflow of %s on %s would be %s, the quota is %s until %s",
+			denom, channelID, flow.Outflow, quota.MaxOutflow, flow.PeriodEnd,
+		)
+	}
+
+	return flow, true, nil
+}
+
+// CheckAndAddInflow adds the amount to the inflow of the denom on the channel,
+// an error is returned if the inflow of the current period exceeds the quota.
+// It is a no-op if there is no quota of the denom on the channel
+func (k Keeper) CheckAndAddInflow(ctx sdk.Context, channelID, denom string, amount sdk.Int) error {
+	quota, found := k.GetQuota(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.GetFlow(ctx, quota)
+	flow.Inflow = flow.Inflow.Add(amount)
+	if !quota.MaxInflow.IsZero() && flow.Inflow.GT(quota.MaxInflow) {
+		return sdkerrors.Wrapf(
+			types.ErrInflowExceeded,
+			"inflow of %s on %s would be %s, the quota is %s until %s",
+			denom, channelID, flow.Inflow, quota.MaxInflow, flow.PeriodEnd,
+		)
+	}
+
+	k.SetFlow(ctx, flow)
+	return nil
+}
+
+// RevertOutflow subtracts the amount of the failed packet sent on the channel from the outflow of
+// the denom. The outflow of a past period is left untouched, so that the quota of the current period
+// can't be raised by the packets sent in the past periods
+func (k Keeper) RevertOutflow(ctx sdk.Context, channelID string, sequence uint64, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSentPacketKey(channelID, sequence)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	store.Delete(key)
+
+	periodEnd, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return
+	}
+
+	quota, found := k.GetQuota(ctx, channelID, denom)
+	if !found {
+		return
+	}
+
+	flow := k.GetFlow(ctx, quota)
+	if !flow.PeriodEnd.Equal(periodEnd) {
+		return
+	}
+
+	if amount.GT(flow.Outflow) {
+		flow.Outflow = sdk.ZeroInt()
+	} else {
+		flow.Outflow = flow.Outflow.Sub(amount)
+	}
+
+	k.SetFlow(ctx, flow)
+}
+
+// Authorized returns true if the address is a guardian super
+func (k Keeper) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.guardianKeeper.Authorized(ctx, addr)
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/modules/ratelimit/keeper"
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	super = sdk.AccAddress([]byte("super_______________"))
+	other = sdk.AccAddress([]byte("other_______________"))
+
+	blockTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	suite.keeper = app.RateLimitKeeper
+
+	app.GuardianKeeper.AddSuper(suite.ctx, guardiantypes.NewSuper("super", guardiantypes.Genesis, super, super))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func newQuota(maxOutflow, maxInflow int64) types.Quota {
+	return types.NewQuota("channel-0", "uiris", sdk.NewInt(maxOutflow), sdk.NewInt(maxInflow), time.Hour)
+}
+
+func (suite *KeeperTestSuite) TestSetQuota() {
+	quota := newQuota(100, 0)
+	suite.keeper.SetQuota(suite.ctx, quota)
+
+	stored, found := suite.keeper.GetQuota(suite.ctx, "channel-0", "uiris")
+	suite.True(found)
+	suite.Equal(quota, stored)
+
+	_, found = suite.keeper.GetQuota(suite.ctx, "channel-1", "uiris")
+	suite.False(found)
+
+	suite.NoError(suite.keeper.DeleteQuota(suite.ctx, "channel-0", "uiris"))
+	_, found = suite.keeper.GetQuota(suite.ctx, "channel-0", "uiris")
+	suite.False(found)
+
+	suite.Error(suite.keeper.DeleteQuota(suite.ctx, "channel-0", "uiris"))
+}
+
+func (suite *KeeperTestSuite) TestCheckAndAddOutflow() {
+	// transfers without quota are not limited
+	suite.NoError(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-0", 1, "uiris", sdk.NewInt(1000)))
+
+	suite.keeper.SetQuota(suite.ctx, newQuota(100, 0))
+
+	suite.NoError(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-0", 2, "uiris", sdk.NewInt(60)))
+	suite.Error(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-0", 3, "uiris", sdk.NewInt(50)))
+	suite.NoError(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-0", 4, "uiris", sdk.NewInt(40)))
+	suite.NoError(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-1", 1, "uiris", sdk.NewInt(1000)))
+
+	// the failed transfers are reverted once
+	quota, _ := suite.keeper.GetQuota(suite.ctx, "channel-0", "uiris")
+	suite.keeper.RevertOutflow(suite.ctx, "channel-0", 4, "uiris", sdk.NewInt(40))
+	suite.Equal(sdk.NewInt(60), suite.keeper.GetFlow(suite.ctx, quota).Outflow)
+	suite.keeper.RevertOutflow(suite.ctx, "channel-0", 4, "uiris", sdk.NewInt(40))
+	suite.Equal(sdk.NewInt(60), suite.keeper.GetFlow(suite.ctx, quota).Outflow)
+
+	// the transfers which were not added to the outflow are not reverted
+	suite.keeper.RevertOutflow(suite.ctx, "channel-0", 1, "uiris", sdk.NewInt(60))
+	suite.keeper.RevertOutflow(suite.ctx, "channel-0", 3, "uiris", sdk.NewInt(50))
+	suite.Equal(sdk.NewInt(60), suite.keeper.GetFlow(suite.ctx, quota).Outflow)
+
+	// the flow is reset when the period ends
+	ctx := suite.ctx.WithBlockTime(blockTime.Add(time.Hour))
+	suite.NoError(suite.keeper.CheckAndAddOutflow(ctx, "channel-0", 5, "uiris", sdk.NewInt(100)))
+	suite.Equal(blockTime.Add(2*time.Hour), suite.keeper.GetFlow(ctx, quota).PeriodEnd)
+
+	// the transfers sent in a past period are not reverted from the current period
+	suite.keeper.RevertOutflow(ctx, "channel-0", 2, "uiris", sdk.NewInt(60))
+	suite.Equal(sdk.NewInt(100), suite.keeper.GetFlow(ctx, quota).Outflow)
+	suite.keeper.RevertOutflow(ctx, "channel-0", 5, "uiris", sdk.NewInt(100))
+	suite.True(suite.keeper.GetFlow(ctx, quota).Outflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestCheckAndAddInflow() {
+	suite.keeper.SetQuota(suite.ctx, newQuota(0, 100))
+
+	suite.NoError(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-0", 1, "uiris", sdk.NewInt(1000)))
+	suite.NoError(suite.keeper.CheckAndAddInflow(suite.ctx, "channel-0", "uiris", sdk.NewInt(100)))
+	suite.Error(suite.keeper.CheckAndAddInflow(suite.ctx, "channel-0", "uiris", sdk.NewInt(1)))
+}
+
+func (suite *KeeperTestSuite) TestMsgSetQuota() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.SetQuota(ctx, types.NewMsgSetQuota(newQuota(100, 100), other))
+	suite.Error(err)
+
+	_, err = msgServer.SetQuota(ctx, types.NewMsgSetQuota(newQuota(100, 100), super))
+	suite.NoError(err)
+	_, found := suite.keeper.GetQuota(suite.ctx, "channel-0", "uiris")
+	suite.True(found)
+
+	_, err = msgServer.DeleteQuota(ctx, types.NewMsgDeleteQuota("channel-0", "uiris", other))
+	suite.Error(err)
+
+	_, err = msgServer.DeleteQuota(ctx, types.NewMsgDeleteQuota("channel-0", "uiris", super))
+	suite.NoError(err)
+	_, found = suite.keeper.GetQuota(suite.ctx, "channel-0", "uiris")
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestHandleQuotaProposal() {
+	suite.keeper.SetQuota(suite.ctx, types.NewQuota("channel-1", "uiris", sdk.NewInt(1), sdk.NewInt(1), time.Hour))
+
+	proposal := types.NewQuotaProposal(
+		"title", "description",
+		[]types.Quota{newQuota(100, 100)},
+		[]types.QuotaID{{ChannelId: "channel-1", Denom: "uiris"}},
+	)
+	suite.NoError(suite.keeper.HandleQuotaProposal(suite.ctx, proposal))
+
+	_, found := suite.keeper.GetQuota(suite.ctx, "channel-0", "uiris")
+	suite.True(found)
+	_, found = suite.keeper.GetQuota(suite.ctx, "channel-1", "uiris")
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryQuota() {
+	suite.keeper.SetQuota(suite.ctx, newQuota(100, 100))
+	suite.NoError(suite.keeper.CheckAndAddOutflow(suite.ctx, "channel-0", 1, "uiris", sdk.NewInt(10)))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.keeper.Quota(ctx, &types.QueryQuotaRequest{ChannelId: "channel-0", Denom: "uiris"})
+	suite.NoError(err)
+	suite.Equal(newQuota(100, 100), res.Quota)
+	suite.Equal(sdk.NewInt(10), res.Flow.Outflow)
+	suite.Equal(blockTime.Add(time.Hour), res.Flow.PeriodEnd)
+
+	_, err = suite.keeper.Quota(ctx, &types.QueryQuotaRequest{ChannelId: "channel-1", Denom: "uiris"})
+	suite.Error(err)
+
+	resQuotas, err := suite.keeper.Quotas(ctx, &types.QueryQuotasRequest{})
+	suite.NoError(err)
+	suite.Len(resQuotas.Quotas, 1)
+
+	resFlows, err := suite.keeper.Flows(ctx, &types.QueryFlowsRequest{})
+	suite.NoError(err)
+	suite.Len(resFlows.Flows, 1)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) SetQuota(goCtx context.Context, msg *types.MsgSetQuota) (*types.MsgSetQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.Authorized(ctx, operator) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a super", msg.Operator)
+	}
+
+	m.Keeper.SetQuota(ctx, msg.Quota)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	)
+
+	return &types.MsgSetQuotaResponse{}, nil
+}
+
+func (m msgServer) DeleteQuota(goCtx context.Context, msg *types.MsgDeleteQuota) (*types.MsgDeleteQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.Authorized(ctx, operator) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a super", msg.Operator)
+	}
+
+	if err := m.Keeper.DeleteQuota(ctx, msg.ChannelId, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	)
+
+	return &types.MsgDeleteQuotaResponse{}, nil
+}
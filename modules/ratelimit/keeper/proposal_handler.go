@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/ratelimit/types"
+)
+
+// HandleQuotaProposal sets and deletes the quotas of the proposal
+func (k Keeper) HandleQuotaProposal(ctx sdk.Context, p *types.QuotaProposal) error {
+	for _, quotaID := range p.DeleteQuotas {
+		if err := k.DeleteQuota(ctx, quotaID.ChannelId, quotaID.Denom); err != nil {
+			return err
+		}
+	}
+
+	for _, quota := range p.SetQuotas {
+		k.SetQuota(ctx, quota)
+	}
+
+	return nil
+}
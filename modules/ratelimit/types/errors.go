@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ratelimit module sentinel errors
+var (
+	ErrInvalidQuota    = sdkerrors.Register(ModuleName, 2, "invalid quota")
+	ErrUnknownQuota    = sdkerrors.Register(ModuleName, 3, "unknown quota")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 4, "unauthorized operator")
+	ErrOutflowExceeded = sdkerrors.Register(ModuleName, 5, "outflow quota exceeded")
+	ErrInflowExceeded  = sdkerrors.Register(ModuleName, 6, "inflow quota exceeded")
+	ErrInvalidFlow     = sdkerrors.Register(ModuleName, 7, "invalid flow")
+)
@@ -0,0 +1,13 @@
+// nolint
+package types
+
+// ratelimit module event types
+const (
+	EventTypeSetQuota    = "set_quota"
+	EventTypeDeleteQuota = "delete_quota"
+
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyDenom     = "denom"
+
+	AttributeValueCategory = ModuleName
+)
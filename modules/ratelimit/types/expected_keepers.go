@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GuardianKeeper defines the expected guardian keeper (noalias)
+type GuardianKeeper interface {
+	Authorized(ctx sdk.Context, addr sdk.AccAddress) bool
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(quotas []Quota, flows []Flow) *GenesisState {
+	return &GenesisState{
+		Quotas: quotas,
+		Flows:  flows,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of the ratelimit genesis data
+func ValidateGenesis(data GenesisState) error {
+	seenQuotas := make(map[string]bool)
+	for _, quota := range data.Quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		id := quota.ChannelId + "/" + quota.Denom
+		if seenQuotas[id] {
+			return fmt.Errorf("duplicate quota: %s", id)
+		}
+		seenQuotas[id] = true
+	}
+
+	seenFlows := make(map[string]bool)
+	for _, flow := range data.Flows {
+		if err := flow.Validate(); err != nil {
+			return err
+		}
+		id := flow.ChannelId + "/" + flow.Denom
+		if !seenQuotas[id] {
+			return fmt.Errorf("flow without quota: %s", id)
+		}
+		if seenFlows[id] {
+			return fmt.Errorf("duplicate flow: %s", id)
+		}
+		seenFlows[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the default store key for ratelimit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for ratelimit
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ratelimit store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	QuotaKeyPrefix      = []byte{0x01} // quota key prefix
+	FlowKeyPrefix       = []byte{0x02} // flow key prefix
+	SentPacketKeyPrefix = []byte{0x03} // sent packet key prefix
+)
+
+// GetQuotaKey returns the key of the quota of the denom on the channel
+func GetQuotaKey(channelID, denom string) []byte {
+	return append(QuotaKeyPrefix, quotaIDBytes(channelID, denom)...)
+}
+
+// GetFlowKey returns the key of the flow of the denom on the channel
+func GetFlowKey(channelID, denom string) []byte {
+	return append(FlowKeyPrefix, quotaIDBytes(channelID, denom)...)
+}
+
+// GetSentPacketKey returns the key of the period end of the outflow the packet sent on the channel is added to
+func GetSentPacketKey(channelID string, sequence uint64) []byte {
+	key := append(SentPacketKeyPrefix, byte(len(channelID)))
+	key = append(key, []byte(channelID)...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func quotaIDBytes(channelID, denom string) []byte {
+	key := append([]byte{byte(len(channelID))}, []byte(channelID)...)
+	return append(key, []byte(denom)...)
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetQuota    = "set_quota"    // type for MsgSetQuota
+	TypeMsgDeleteQuota = "delete_quota" // type for MsgDeleteQuota
+)
+
+var (
+	_ sdk.Msg = &MsgSetQuota{}
+	_ sdk.Msg = &MsgDeleteQuota{}
+)
+
+// NewMsgSetQuota constructs a MsgSetQuota
+func NewMsgSetQuota(quota Quota, operator sdk.AccAddress) *MsgSetQuota {
+	return &MsgSetQuota{
+		Quota:    quota,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSetQuota) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSetQuota) Type() string { return TypeMsgSetQuota }
+
+// GetSignBytes implements Msg.
+func (msg MsgSetQuota) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSetQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if err := msg.Quota.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQuota, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgSetQuota) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// ______________________________________________________________________
+
+// NewMsgDeleteQuota constructs a MsgDeleteQuota
+func NewMsgDeleteQuota(channelID, denom string, operator sdk.AccAddress) *MsgDeleteQuota {
+	return &MsgDeleteQuota{
+		ChannelId: channelID,
+		Denom:     denom,
+		Operator:  operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgDeleteQuota) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgDeleteQuota) Type() string { return TypeMsgDeleteQuota }
+
+// GetSignBytes implements Msg.
+func (msg MsgDeleteQuota) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgDeleteQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if err := ValidateQuotaID(msg.ChannelId, msg.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidQuota, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgDeleteQuota) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeQuota defines the type for a QuotaProposal
+	ProposalTypeQuota = "RateLimitQuota"
+)
+
+// Implements Proposal Interface
+var _ govtypes.Content = &QuotaProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeQuota)
+	govtypes.RegisterProposalTypeCodec(&QuotaProposal{}, "irishub/ratelimit/QuotaProposal")
+}
+
+// NewQuotaProposal creates a new quota proposal.
+func NewQuotaProposal(title, description string, setQuotas []Quota, deleteQuotas []QuotaID) *QuotaProposal {
+	return &QuotaProposal{
+		Title:        title,
+		Description:  description,
+		SetQuotas:    setQuotas,
+		DeleteQuotas: deleteQuotas,
+	}
+}
+
+// GetTitle returns the title of a quota proposal.
+func (qp *QuotaProposal) GetTitle() string { return qp.Title }
+
+// GetDescription returns the description of a quota proposal.
+func (qp *QuotaProposal) GetDescription() string { return qp.Description }
+
+// ProposalRoute returns the routing key of a quota proposal.
+func (qp *QuotaProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a quota proposal.
+func (qp *QuotaProposal) ProposalType() string { return ProposalTypeQuota }
+
+// ValidateBasic runs basic stateless validity checks
+func (qp *QuotaProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(qp); err != nil {
+		return err
+	}
+
+	if len(qp.SetQuotas) == 0 && len(qp.DeleteQuotas) == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "no quota to set or delete")
+	}
+
+	seenQuotas := make(map[string]bool)
+	for _, quota := range qp.SetQuotas {
+		if err := quota.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidQuota, err.Error())
+		}
+		if id := quota.ChannelId + "/" + quota.Denom; seenQuotas[id] {
+			return sdkerrors.Wrapf(ErrInvalidQuota, "duplicate quota: %s", id)
+		} else {
+			seenQuotas[id] = true
+		}
+	}
+	for _, quotaID := range qp.DeleteQuotas {
+		if err := ValidateQuotaID(quotaID.ChannelId, quotaID.Denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidQuota, err.Error())
+		}
+		if id := quotaID.ChannelId + "/" + quotaID.Denom; seenQuotas[id] {
+			return sdkerrors.Wrapf(ErrInvalidQuota, "duplicate quota: %s", id)
+		} else {
+			seenQuotas[id] = true
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (qp QuotaProposal) String() string {
+	out, _ := yaml.Marshal(qp)
+	return fmt.Sprintf("Rate Limit Quota Proposal:\n%s", out)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQuotaProposalValidateBasic(t *testing.T) {
+	quota := NewQuota("channel-0", "uiris", sdk.NewInt(100), sdk.ZeroInt(), time.Hour)
+	quotaID := QuotaID{ChannelId: "channel-1", Denom: "uiris"}
+
+	tests := []struct {
+		name     string
+		proposal *QuotaProposal
+		wantErr  bool
+	}{
+		{"valid", NewQuotaProposal("title", "description", []Quota{quota}, []QuotaID{quotaID}), false},
+		{"empty title", NewQuotaProposal("", "description", []Quota{quota}, nil), true},
+		{"no quota", NewQuotaProposal("title", "description", nil, nil), true},
+		{"invalid channel", NewQuotaProposal("title", "description", []Quota{NewQuota("channel", "uiris", sdk.NewInt(100), sdk.ZeroInt(), time.Hour)}, nil), true},
+		{"negative max outflow", NewQuotaProposal("title", "description", []Quota{NewQuota("channel-0", "uiris", sdk.NewInt(-1), sdk.ZeroInt(), time.Hour)}, nil), true},
+		{"zero period", NewQuotaProposal("title", "description", []Quota{NewQuota("channel-0", "uiris", sdk.NewInt(100), sdk.ZeroInt(), 0)}, nil), true},
+		{"duplicate quota", NewQuotaProposal("title", "description", []Quota{quota}, []QuotaID{{ChannelId: "channel-0", Denom: "uiris"}}), true},
+	}
+
+	for _, tt := range tests {
+		err := tt.proposal.ValidateBasic()
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
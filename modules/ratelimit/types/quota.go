@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// NewQuota creates a new Quota instance
+func NewQuota(channelID, denom string, maxOutflow, maxInflow sdk.Int, period time.Duration) Quota {
+	return Quota{
+		ChannelId:  channelID,
+		Denom:      denom,
+		MaxOutflow: maxOutflow,
+		MaxInflow:  maxInflow,
+		Period:     period,
+	}
+}
+
+// Validate returns err if the Quota is invalid
+func (q Quota) Validate() error {
+	if err := ValidateQuotaID(q.ChannelId, q.Denom); err != nil {
+		return err
+	}
+	if q.MaxOutflow.IsNil() || q.MaxOutflow.IsNegative() {
+		return fmt.Errorf("max outflow must not be negative: %s", q.MaxOutflow)
+	}
+	if q.MaxInflow.IsNil() || q.MaxInflow.IsNegative() {
+		return fmt.Errorf("max inflow must not be negative: %s", q.MaxInflow)
+	}
+	if q.Period <= 0 {
+		return fmt.Errorf("period must be positive: %s", q.Period)
+	}
+	return nil
+}
+
+// ValidateQuotaID returns err if the channel id or the denom of a quota is invalid
+func ValidateQuotaID(channelID, denom string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+// NewFlow creates a new Flow instance with empty counters
+func NewFlow(channelID, denom string, periodEnd time.Time) Flow {
+	return Flow{
+		ChannelId: channelID,
+		Denom:     denom,
+		Outflow:   sdk.ZeroInt(),
+		Inflow:    sdk.ZeroInt(),
+		PeriodEnd: periodEnd,
+	}
+}
+
+// Validate returns err if the Flow is invalid
+func (f Flow) Validate() error {
+	if err := ValidateQuotaID(f.ChannelId, f.Denom); err != nil {
+		return err
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return fmt.Errorf("outflow must not be negative: %s", f.Outflow)
+	}
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return fmt.Errorf("inflow must not be negative: %s", f.Inflow)
+	}
+	return nil
+}
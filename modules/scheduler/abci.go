@@ -0,0 +1,12 @@
+package scheduler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/scheduler/keeper"
+)
+
+// BeginBlocker executes the due schedules
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ExecuteDueSchedules(ctx)
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	"github.com/irisnet/irishub/modules/scheduler/types"
+)
+
+const (
+	FlagHeight      = "height"
+	FlagTime        = "time"
+	FlagScheduleGas = "schedule-gas"
+	FlagScheduleFee = "schedule-fee"
+)
+
+// NewTxCmd returns the transaction commands for the scheduler module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "scheduler transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSchedule(),
+		GetCmdCancelSchedule(),
+	)
+	return txCmd
+}
+
+// GetCmdSchedule implements the schedule command.
+func GetCmdSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [tx-json-file]",
+		Short: "Schedule the msgs of a tx for execution at a future height or time",
+		Long: `Schedule the msgs of an unsigned tx, e.g. generated with --generate-only, for execution at a future height
+or time. The msgs must be signed by the sender of the schedule only. The schedule fee is escrowed until the
+execution and paid to the fee collector whether the msgs succeed or not, it is refunded if the schedule is cancelled.`,
+		Example: fmt.Sprintf(
+			"%s tx scheduler schedule tx.json --height=1000000 --schedule-gas=200000 --schedule-fee=1iris "+
+				"--chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduledTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+
+			var t *time.Time
+			if timeStr, _ := cmd.Flags().GetString(FlagTime); timeStr != "" {
+				parsed, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return err
+				}
+				t = &parsed
+			}
+
+			gasLimit, _ := cmd.Flags().GetUint64(FlagScheduleGas)
+
+			feeStr, _ := cmd.Flags().GetString(FlagScheduleFee)
+			fee, err := sdk.ParseCoinsNormalized(feeStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSchedule(clientCtx.GetFromAddress(), scheduledTx.GetMsgs(), height, t, gasLimit, fee)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Int64(FlagHeight, 0, "Height at which the msgs are executed")
+	cmd.Flags().String(FlagTime, "", "Time after which the msgs are executed, in RFC3339 format")
+	cmd.Flags().Uint64(FlagScheduleGas, 200000, "Gas limit of the execution of the msgs")
+	cmd.Flags().String(FlagScheduleFee, "", "Fee escrowed until the execution of the msgs")
+	_ = cmd.MarkFlagRequired(FlagScheduleFee)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelSchedule implements the cancel schedule command.
+func GetCmdCancelSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [schedule-id]",
+		Short: "Cancel a pending schedule and refund its fee",
+		Example: fmt.Sprintf(
+			"%s tx scheduler cancel 1 --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSchedule(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
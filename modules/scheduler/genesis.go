@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/scheduler/keeper"
+	"github.com/irisnet/irishub/modules/scheduler/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize scheduler genesis state: %s", err.Error()))
+	}
+
+	k.SetParams(ctx, data.Params)
+	k.SetNextScheduleID(ctx, data.NextScheduleId)
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var schedules []types.Schedule
+	k.IterateSchedules(
+		ctx,
+		func(schedule types.Schedule) bool {
+			schedules = append(schedules, schedule)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(k.GetParams(ctx), schedules, k.GetNextScheduleID(ctx))
+}
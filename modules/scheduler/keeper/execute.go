@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/scheduler/types"
+)
+
+// ExecuteDueSchedules executes the schedules whose height or time has been reached, in the order
+// of their ids. At most MaxSchedulesPerBlock schedules whose total gas limit does not exceed
+// MaxGasPerBlock are executed in a block, the other due schedules are executed in the next blocks.
+// The fee of an executed schedule is paid to the fee collector whether its msgs succeed or not
+func (k Keeper) ExecuteDueSchedules(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	var totalGas uint64
+	for i, id := range k.dueScheduleIDs(ctx, int(params.MaxSchedulesPerBlock)) {
+		schedule, found := k.GetSchedule(ctx, id)
+		if !found {
+			continue
+		}
+
+		// the first schedule is always executed so that the queue can not be blocked
+		// by a schedule whose gas limit exceeds a lowered MaxGasPerBlock
+		if i > 0 && totalGas+schedule.GasLimit > params.MaxGasPerBlock {
+			break
+		}
+		totalGas += schedule.GasLimit
+
+		// the schedule is removed before its execution so that its msgs can not cancel it
+		k.deleteSchedule(ctx, schedule)
+		gasUsed, err := k.executeSchedule(ctx, schedule)
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, schedule.Fee); err != nil {
+			k.Logger(ctx).Error("failed to pay the schedule fee", "id", schedule.Id, "err", err.Error())
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeExecuteSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, schedule.Creator),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		)
+		if err != nil {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+			k.Logger(ctx).Info("scheduled msgs failed", "id", schedule.Id, "err", err.Error())
+		}
+		ctx.EventManager().EmitEvent(event)
+	}
+}
+
+// dueScheduleIDs returns the ids of at most limit due schedules in ascending order
+func (k Keeper) dueScheduleIDs(ctx sdk.Context, limit int) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var ids []uint64
+	collect := func(start, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+
+		for n := 0; iterator.Valid() && n < limit; iterator.Next() {
+			ids = append(ids, types.ParseQueueKeyID(iterator.Key()))
+			n++
+		}
+	}
+
+	collect(types.HeightQueueKeyPrefix, sdk.PrefixEndBytes(types.GetHeightQueuePrefix(ctx.BlockHeight())))
+	collect(types.TimeQueueKeyPrefix, sdk.PrefixEndBytes(types.GetTimeQueuePrefix(ctx.BlockTime())))
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+// executeSchedule validates and executes the msgs of the schedule with a gas meter limited to its
+// gas limit, the state changes and the events of the msgs are discarded if one of them fails
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) (gasUsed uint64, err error) {
+	gasMeter := sdk.NewGasMeter(schedule.GasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(
+					sdkerrors.ErrOutOfGas, "out of gas in location: %v; gas limit: %d",
+					rType.Descriptor, schedule.GasLimit,
+				)
+			default:
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "panic in scheduled msgs: %v", r)
+			}
+		}
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}()
+
+	msgs, err := schedule.GetMessages()
+	if err != nil {
+		return 0, err
+	}
+
+	handlers := make([]baseapp.MsgServiceHandler, len(msgs))
+	reqs := make([]sdk.MsgRequest, len(msgs))
+	// the validator checks the requests of the service msgs
+	validated := make([]sdk.Msg, 0, len(msgs))
+	for i, msg := range msgs {
+		if handlers[i], reqs[i], err = k.router.Route(msg); err != nil {
+			return 0, err
+		}
+		if msg, ok := reqs[i].(sdk.Msg); ok {
+			validated = append(validated, msg)
+		}
+	}
+
+	if k.validator != nil {
+		if err := k.validator(cacheCtx, validated); err != nil {
+			return 0, err
+		}
+	}
+
+	var events sdk.Events
+	for i, handler := range handlers {
+		res, err := handler(cacheCtx, reqs[i])
+		if err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to execute msg %d", i)
+		}
+		for _, event := range res.GetEvents() {
+			events = append(events, sdk.Event(event))
+		}
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(events)
+	return 0, nil
+}
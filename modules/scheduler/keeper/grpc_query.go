@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/scheduler/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Schedule implements the Query/Schedule gRPC method
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := k.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %d not found", req.Id)
+	}
+
+	return &types.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// Schedules implements the Query/Schedules gRPC method
+func (k Keeper) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var schedules []types.Schedule
+
+	if len(req.Creator) == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+		pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+			var schedule types.Schedule
+			if err := k.cdc.UnmarshalBinaryBare(value, &schedule); err != nil {
+				return err
+			}
+			schedules = append(schedules, schedule)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+		}
+
+		return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCreatorIndexPrefix(creator))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		schedule, found := k.GetSchedule(ctx, types.ParseQueueKeyID(key))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed schedule %d not found", types.ParseQueueKeyID(key))
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
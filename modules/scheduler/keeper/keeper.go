@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/scheduler/types"
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+// Keeper of the scheduler store
+type Keeper struct {
+	cdc              codec.Marshaler
+	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	bankKeeper       types.BankKeeper
+	router           msgrouter.Router
+	feeCollectorName string
+	validator        types.MsgValidator
+}
+
+// NewKeeper returns a scheduler keeper. The scheduled msgs are executed by the Msg service router,
+// the legacy msgs are routed to the Msg service methods taking them as requests
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, router *baseapp.MsgServiceRouter,
+	registry codectypes.InterfaceRegistry, feeCollectorName string,
+) Keeper {
+	// ensure scheduler module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the scheduler module account has not been set")
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		bankKeeper:       bk,
+		router:           msgrouter.NewRouter(router, registry),
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// SetMsgValidator sets the validator of the scheduled msgs, which is run when they are executed
+func (k *Keeper) SetMsgValidator(validator types.MsgValidator) *Keeper {
+	if k.validator != nil {
+		panic("cannot set scheduler msg validator twice")
+	}
+	k.validator = validator
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// CreateSchedule validates the msgs against the parameters, escrows the fee and stores the schedule
+func (k Keeper) CreateSchedule(
+	ctx sdk.Context, creator sdk.AccAddress, msgs []*codectypes.Any,
+	height int64, t *time.Time, gasLimit uint64, fee sdk.Coins,
+) (uint64, error) {
+	params := k.GetParams(ctx)
+
+	if uint32(len(msgs)) > params.MaxMsgsPerSchedule {
+		return 0, sdkerrors.Wrapf(types.ErrTooManyMsgs, "%d msgs, the maximum is %d", len(msgs), params.MaxMsgsPerSchedule)
+	}
+	if gasLimit > params.MaxGasPerSchedule || gasLimit > params.MaxGasPerBlock {
+		return 0, sdkerrors.Wrapf(types.ErrGasLimitExceeded, "gas limit %d, the maximum is %d", gasLimit, params.MaxGasPerSchedule)
+	}
+
+	if t != nil {
+		if !t.After(ctx.BlockTime()) {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidTrigger, "time %s must be after the block time %s", t, ctx.BlockTime())
+		}
+		if maxTime := ctx.BlockTime().Add(params.MaxTimeDelay); t.After(maxTime) {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidTrigger, "time %s must not be after %s", t, maxTime)
+		}
+	} else {
+		if height <= ctx.BlockHeight() {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidTrigger, "height %d must be greater than the block height %d", height, ctx.BlockHeight())
+		}
+		if maxHeight := ctx.BlockHeight() + params.MaxHeightDelay; height > maxHeight {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidTrigger, "height %d must not be greater than %d", height, maxHeight)
+		}
+	}
+
+	unpacked, err := msgrouter.UnpackMsgs(msgs)
+	if err != nil {
+		return 0, err
+	}
+	for _, msg := range unpacked {
+		if _, _, err := k.router.Route(msg); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, fee); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextScheduleID(ctx)
+	k.SetNextScheduleID(ctx, id+1)
+
+	schedule := types.NewSchedule(id, creator, msgs, height, t, gasLimit, fee)
+	k.SetSchedule(ctx, schedule)
+
+	event := sdk.NewEvent(
+		types.EventTypeSchedule,
+		sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyCreator, schedule.Creator),
+	)
+	if t != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyTime, t.UTC().Format(time.RFC3339Nano)))
+	} else {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	return id, nil
+}
+
+// CancelSchedule removes a pending schedule of the creator and refunds its fee
+func (k Keeper) CancelSchedule(ctx sdk.Context, creator sdk.AccAddress, id uint64) error {
+	schedule, found := k.GetSchedule(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSchedule, "schedule %d not found", id)
+	}
+	if schedule.Creator != creator.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the creator of schedule %d", creator, id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, schedule.Fee); err != nil {
+		return err
+	}
+	k.deleteSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, schedule.Creator),
+		),
+	)
+
+	return nil
+}
+
+// SetSchedule stores the schedule and adds it to the queue and the creator index
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&schedule)
+	store.Set(types.GetScheduleKey(schedule.Id), bz)
+	store.Set(queueKey(schedule), []byte{})
+
+	creator, _ := sdk.AccAddressFromBech32(schedule.Creator)
+	store.Set(types.GetCreatorIndexKey(creator, schedule.Id), []byte{})
+}
+
+// GetSchedule returns the pending schedule of the id
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (schedule types.Schedule, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduleKey(id))
+	if bz == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return schedule, true
+}
+
+// IterateSchedules iterates over all the pending schedules by id
+func (k Keeper) IterateSchedules(ctx sdk.Context, op func(schedule types.Schedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduleKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &schedule)
+
+		if stop := op(schedule); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) deleteSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduleKey(schedule.Id))
+	store.Delete(queueKey(schedule))
+
+	creator, _ := sdk.AccAddressFromBech32(schedule.Creator)
+	store.Delete(types.GetCreatorIndexKey(creator, schedule.Id))
+}
+
+// GetNextScheduleID returns the id of the next schedule
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID stores the id of the next schedule
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetParams returns the parameters of the scheduler module
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the scheduler module
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+func queueKey(schedule types.Schedule) []byte {
+	if schedule.Time != nil {
+		return types.GetTimeQueueKey(*schedule.Time, schedule.Id)
+	}
+	return types.GetHeightQueueKey(schedule.Height, schedule.Id)
+}
@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irisnet/irishub/modules/scheduler/keeper"
+	"github.com/irisnet/irishub/modules/scheduler/types"
+	"github.com/irisnet/irishub/msgrouter"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	creator   = sdk.AccAddress([]byte("creator_____________"))
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+	other     = sdk.AccAddress([]byte("other_______________"))
+
+	blockTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fee       = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	amount    = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: blockTime})
+	suite.keeper = app.SchedulerKeeper
+
+	suite.NoError(app.BankKeeper.SetBalances(suite.ctx, creator, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) packSend(coins sdk.Coins) []*codectypes.Any {
+	msgs, err := msgrouter.PackMsgs([]sdk.Msg{banktypes.NewMsgSend(creator, recipient, coins)})
+	suite.NoError(err)
+	return msgs
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return suite.app.BankKeeper.GetAllBalances(suite.ctx, addr)
+}
+
+func (suite *KeeperTestSuite) feeCollector() sdk.AccAddress {
+	return suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+}
+
+// nextBlock moves the context to the given height and time and executes the due schedules
+func (suite *KeeperTestSuite) nextBlock(height int64, t time.Time) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ExecuteDueSchedules(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestCreateSchedule() {
+	params := suite.keeper.GetParams(suite.ctx)
+	tooLate := blockTime.Add(params.MaxTimeDelay + time.Second)
+
+	testCases := []struct {
+		name     string
+		msgs     []*codectypes.Any
+		height   int64
+		time     *time.Time
+		gasLimit uint64
+		err      error
+	}{
+		{"current height", suite.packSend(amount), 10, nil, 200000, types.ErrInvalidTrigger},
+		{"height too far", suite.packSend(amount), 11 + params.MaxHeightDelay, nil, 200000, types.ErrInvalidTrigger},
+		{"past time", suite.packSend(amount), 0, &blockTime, 200000, types.ErrInvalidTrigger},
+		{"time too far", suite.packSend(amount), 0, &tooLate, 200000, types.ErrInvalidTrigger},
+		{"gas limit too high", suite.packSend(amount), 11, nil, params.MaxGasPerSchedule + 1, types.ErrGasLimitExceeded},
+		{"too many msgs", make([]*codectypes.Any, params.MaxMsgsPerSchedule+1), 11, nil, 200000, types.ErrTooManyMsgs},
+		{"valid", suite.packSend(amount), 11, nil, 200000, nil},
+	}
+
+	for _, tc := range testCases {
+		id, err := suite.keeper.CreateSchedule(suite.ctx, creator, tc.msgs, tc.height, tc.time, tc.gasLimit, fee)
+		if tc.err != nil {
+			suite.ErrorIs(err, tc.err, tc.name)
+			continue
+		}
+		suite.NoError(err, tc.name)
+		suite.Equal(uint64(1), id)
+	}
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, 1)
+	suite.True(found)
+	suite.Equal(creator.String(), schedule.Creator)
+	suite.Equal(fee, schedule.Fee)
+	suite.Equal(uint64(2), suite.keeper.GetNextScheduleID(suite.ctx))
+
+	// the fee is escrowed by the module account
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Equal(fee, suite.balance(moduleAddr))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 990)), suite.balance(creator))
+
+	// the fee can not exceed the balance of the creator
+	_, err := suite.keeper.CreateSchedule(suite.ctx, other, suite.packSend(amount), 11, nil, 200000, fee)
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduleByHeight() {
+	id, err := suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(amount), 12, nil, 200000, fee)
+	suite.NoError(err)
+
+	// the schedule is not due before its height
+	suite.nextBlock(11, blockTime.Add(5*time.Second))
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.True(found)
+	suite.True(suite.balance(recipient).IsZero())
+
+	suite.nextBlock(12, blockTime.Add(10*time.Second))
+	_, found = suite.keeper.GetSchedule(suite.ctx, id)
+	suite.False(found)
+	suite.Equal(amount, suite.balance(recipient))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 890)), suite.balance(creator))
+	suite.Equal(fee, suite.balance(suite.feeCollector()))
+
+	event := suite.ctx.EventManager().Events()[len(suite.ctx.EventManager().Events())-1]
+	suite.Equal(types.EventTypeExecuteSchedule, event.Type)
+	suite.Contains(event.Attributes, sdk.NewAttribute(types.AttributeKeySuccess, "true").ToKVPair())
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduleByTime() {
+	t := blockTime.Add(time.Minute)
+	id, err := suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(amount), 0, &t, 200000, fee)
+	suite.NoError(err)
+
+	suite.nextBlock(20, t.Add(-time.Second))
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.True(found)
+
+	suite.nextBlock(21, t)
+	_, found = suite.keeper.GetSchedule(suite.ctx, id)
+	suite.False(found)
+	suite.Equal(amount, suite.balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduleFailed() {
+	// the send exceeds the balance of the creator
+	_, err := suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(sdk.NewCoins(sdk.NewInt64Coin("stake", 5000))), 11, nil, 200000, fee)
+	suite.NoError(err)
+	// the gas limit is too low to execute the send
+	_, err = suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(amount), 11, nil, 100, fee)
+	suite.NoError(err)
+
+	suite.nextBlock(11, blockTime.Add(5*time.Second))
+
+	// the sends are reverted but the fees are paid
+	suite.True(suite.balance(recipient).IsZero())
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 980)), suite.balance(creator))
+	suite.Equal(fee.Add(fee...), suite.balance(suite.feeCollector()))
+
+	var failed int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExecuteSchedule {
+			suite.Contains(event.Attributes, sdk.NewAttribute(types.AttributeKeySuccess, "false").ToKVPair())
+			failed++
+		}
+	}
+	suite.Equal(2, failed)
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduleLimits() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxSchedulesPerBlock = 2
+	params.MaxGasPerBlock = 500000
+	suite.keeper.SetParams(suite.ctx, params)
+
+	for i := 0; i < 4; i++ {
+		_, err := suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(amount), 11, nil, 200000, fee)
+		suite.NoError(err)
+	}
+	// the schedule exceeding the gas of the block is deferred to the next block
+	params.MaxGasPerBlock = 300000
+	suite.keeper.SetParams(suite.ctx, params)
+
+	for i, executed := range []int64{1, 2, 3, 4} {
+		suite.nextBlock(11+int64(i), blockTime.Add(time.Duration(i)*5*time.Second))
+		suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100*executed)), suite.balance(recipient))
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelSchedule() {
+	id, err := suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(amount), 11, nil, 200000, fee)
+	suite.NoError(err)
+
+	err = suite.keeper.CancelSchedule(suite.ctx, other, id)
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.keeper.CancelSchedule(suite.ctx, creator, id+1)
+	suite.ErrorIs(err, types.ErrUnknownSchedule)
+
+	suite.NoError(suite.keeper.CancelSchedule(suite.ctx, creator, id))
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.False(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), suite.balance(creator))
+
+	// the canceled schedule is not executed
+	suite.nextBlock(11, blockTime.Add(5*time.Second))
+	suite.True(suite.balance(recipient).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMsgValidator() {
+	errRejected := errors.New("rejected")
+	k := suite.keeper
+	k.SetMsgValidator(func(ctx sdk.Context, msgs []sdk.Msg) error {
+		for _, msg := range msgs {
+			if msg, ok := msg.(*banktypes.MsgSend); ok && msg.ToAddress == recipient.String() {
+				return errRejected
+			}
+		}
+		return nil
+	})
+	suite.keeper = k
+
+	_, err := suite.keeper.CreateSchedule(suite.ctx, creator, suite.packSend(amount), 11, nil, 200000, fee)
+	suite.NoError(err)
+
+	suite.nextBlock(11, blockTime.Add(5*time.Second))
+	suite.True(suite.balance(recipient).IsZero())
+	suite.Equal(fee, suite.balance(suite.feeCollector()))
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// scheduler module sentinel errors
+var (
+	ErrInvalidSchedule  = sdkerrors.Register(ModuleName, 2, "invalid schedule")
+	ErrUnknownSchedule  = sdkerrors.Register(ModuleName, 3, "unknown schedule")
+	ErrInvalidMsg       = sdkerrors.Register(ModuleName, 4, "invalid scheduled msg")
+	ErrInvalidTrigger   = sdkerrors.Register(ModuleName, 5, "invalid schedule height or time")
+	ErrGasLimitExceeded = sdkerrors.Register(ModuleName, 6, "gas limit exceeded")
+	ErrTooManyMsgs      = sdkerrors.Register(ModuleName, 7, "too many scheduled msgs")
+	ErrUnauthorized     = sdkerrors.Register(ModuleName, 8, "unauthorized")
+)
@@ -0,0 +1,19 @@
+// nolint
+package types
+
+// scheduler module event types
+const (
+	EventTypeSchedule        = "schedule"
+	EventTypeCancelSchedule  = "cancel_schedule"
+	EventTypeExecuteSchedule = "execute_schedule"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyCreator    = "creator"
+	AttributeKeyHeight     = "height"
+	AttributeKeyTime       = "time"
+	AttributeKeySuccess    = "success"
+	AttributeKeyGasUsed    = "gas_used"
+	AttributeKeyError      = "error"
+
+	AttributeValueCategory = ModuleName
+)
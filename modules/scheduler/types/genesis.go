@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params, schedules []Schedule, nextScheduleID uint64) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		Schedules:      schedules,
+		NextScheduleId: nextScheduleID,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1)
+}
+
+// ValidateGenesis performs basic validation of the scheduler genesis data
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.NextScheduleId == 0 {
+		return fmt.Errorf("next schedule id must be positive")
+	}
+
+	seenIDs := make(map[uint64]bool)
+	for _, schedule := range data.Schedules {
+		if err := schedule.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenIDs[schedule.Id] {
+			return fmt.Errorf("duplicate schedule id %d", schedule.Id)
+		}
+		if schedule.Id >= data.NextScheduleId {
+			return fmt.Errorf("schedule id %d must be less than the next schedule id %d", schedule.Id, data.NextScheduleId)
+		}
+		seenIDs[schedule.Id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, schedule := range data.Schedules {
+		if err := schedule.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
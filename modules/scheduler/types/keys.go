@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// module name
+	ModuleName = "scheduler"
+
+	// StoreKey is the default store key for scheduler
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for scheduler
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the scheduler store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	ScheduleKeyPrefix     = []byte{0x01} // schedule key prefix
+	HeightQueueKeyPrefix  = []byte{0x02} // prefix of the queue of the schedules triggered by height
+	TimeQueueKeyPrefix    = []byte{0x03} // prefix of the queue of the schedules triggered by time
+	CreatorIndexKeyPrefix = []byte{0x04} // prefix of the index of the schedules by creator
+	NextScheduleIDKey     = []byte{0x05} // key of the id of the next schedule
+)
+
+// GetScheduleKey returns the key of the schedule
+func GetScheduleKey(id uint64) []byte {
+	return append(ScheduleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetHeightQueueKey returns the key of the schedule in the height queue
+func GetHeightQueueKey(height int64, id uint64) []byte {
+	return append(GetHeightQueuePrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetHeightQueuePrefix returns the prefix of the schedules executed at the height in the height queue
+func GetHeightQueuePrefix(height int64) []byte {
+	return append(HeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetTimeQueueKey returns the key of the schedule in the time queue
+func GetTimeQueueKey(t time.Time, id uint64) []byte {
+	return append(GetTimeQueuePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTimeQueuePrefix returns the prefix of the schedules executed at the time in the time queue
+func GetTimeQueuePrefix(t time.Time) []byte {
+	return append(TimeQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+// GetCreatorIndexKey returns the key of the schedule in the creator index
+func GetCreatorIndexKey(creator sdk.AccAddress, id uint64) []byte {
+	return append(GetCreatorIndexPrefix(creator), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetCreatorIndexPrefix returns the prefix of the schedules of the creator in the creator index
+func GetCreatorIndexPrefix(creator sdk.AccAddress) []byte {
+	key := append(CreatorIndexKeyPrefix, byte(len(creator)))
+	return append(key, creator...)
+}
+
+// ParseQueueKeyID returns the schedule id of a key of the queues or of the creator index
+func ParseQueueKeyID(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+const (
+	TypeMsgSchedule       = "schedule"        // type for MsgSchedule
+	TypeMsgCancelSchedule = "cancel_schedule" // type for MsgCancelSchedule
+)
+
+var (
+	_ sdk.Msg = &MsgSchedule{}
+	_ sdk.Msg = &MsgCancelSchedule{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgSchedule{}
+)
+
+// NewMsgSchedule constructs a MsgSchedule, either a height or a time must be set
+func NewMsgSchedule(
+	creator sdk.AccAddress, msgs []sdk.Msg, height int64, t *time.Time, gasLimit uint64, fee sdk.Coins,
+) (*MsgSchedule, error) {
+	anys, err := msgrouter.PackMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSchedule{
+		Creator:  creator.String(),
+		Msgs:     anys,
+		Height:   height,
+		Time:     t,
+		GasLimit: gasLimit,
+		Fee:      fee,
+	}, nil
+}
+
+// Route implements Msg.
+func (msg MsgSchedule) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSchedule) Type() string { return TypeMsgSchedule }
+
+// GetSignBytes implements Msg.
+func (msg MsgSchedule) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSchedule) ValidateBasic() error {
+	return validateSchedule(msg.Creator, msg.Msgs, msg.Height, msg.Time, msg.GasLimit, msg.Fee)
+}
+
+// GetSigners implements Msg.
+func (msg MsgSchedule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetMessages returns the unpacked scheduled msgs
+func (msg MsgSchedule) GetMessages() ([]sdk.Msg, error) {
+	return msgrouter.UnpackMsgs(msg.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSchedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msgrouter.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// NewMsgCancelSchedule constructs a MsgCancelSchedule
+func NewMsgCancelSchedule(creator sdk.AccAddress, id uint64) *MsgCancelSchedule {
+	return &MsgCancelSchedule{
+		Creator: creator.String(),
+		Id:      id,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgCancelSchedule) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgCancelSchedule) Type() string { return TypeMsgCancelSchedule }
+
+// GetSignBytes implements Msg.
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrUnknownSchedule, "schedule id must be positive")
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
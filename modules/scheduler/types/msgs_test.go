@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	creator = sdk.AccAddress([]byte("creator_____________"))
+	other   = sdk.AccAddress([]byte("other_______________"))
+
+	fee   = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+func newMsgSchedule(t *testing.T, msgs []sdk.Msg, height int64, trigger *time.Time, gasLimit uint64, fee sdk.Coins) *MsgSchedule {
+	msg, err := NewMsgSchedule(creator, msgs, height, trigger, gasLimit, fee)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestMsgScheduleValidation(t *testing.T) {
+	send := banktypes.NewMsgSend(creator, other, coins)
+	now := time.Now()
+
+	svcMsg := sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: send}
+	nested := newMsgSchedule(t, []sdk.Msg{send}, 10, nil, 100000, fee)
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgSchedule
+	}{
+		{"pass with height", true, newMsgSchedule(t, []sdk.Msg{send}, 10, nil, 100000, fee)},
+		{"pass with time", true, newMsgSchedule(t, []sdk.Msg{send}, 0, &now, 100000, fee)},
+		{"pass with service msg", true, newMsgSchedule(t, []sdk.Msg{svcMsg}, 10, nil, 100000, fee)},
+		{"no trigger", false, newMsgSchedule(t, []sdk.Msg{send}, 0, nil, 100000, fee)},
+		{"height and time", false, newMsgSchedule(t, []sdk.Msg{send}, 10, &now, 100000, fee)},
+		{"negative height", false, newMsgSchedule(t, []sdk.Msg{send}, -1, nil, 100000, fee)},
+		{"no gas limit", false, newMsgSchedule(t, []sdk.Msg{send}, 10, nil, 0, fee)},
+		{"no fee", false, newMsgSchedule(t, []sdk.Msg{send}, 10, nil, 100000, nil)},
+		{"no msgs", false, newMsgSchedule(t, nil, 10, nil, 100000, fee)},
+		{"invalid msg", false, newMsgSchedule(t, []sdk.Msg{banktypes.NewMsgSend(creator, other, nil)}, 10, nil, 100000, fee)},
+		{"other signer", false, newMsgSchedule(t, []sdk.Msg{banktypes.NewMsgSend(other, creator, coins)}, 10, nil, 100000, fee)},
+		{"nested schedule", false, newMsgSchedule(t, []sdk.Msg{nested}, 10, nil, 100000, fee)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
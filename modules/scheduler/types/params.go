@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyMaxSchedulesPerBlock = []byte("MaxSchedulesPerBlock")
+	KeyMaxGasPerBlock       = []byte("MaxGasPerBlock")
+	KeyMaxGasPerSchedule    = []byte("MaxGasPerSchedule")
+	KeyMaxMsgsPerSchedule   = []byte("MaxMsgsPerSchedule")
+	KeyMaxHeightDelay       = []byte("MaxHeightDelay")
+	KeyMaxTimeDelay         = []byte("MaxTimeDelay")
+)
+
+// ParamKeyTable for scheduler module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxSchedulesPerBlock uint32, maxGasPerBlock, maxGasPerSchedule uint64,
+	maxMsgsPerSchedule uint32, maxHeightDelay int64, maxTimeDelay time.Duration,
+) Params {
+	return Params{
+		MaxSchedulesPerBlock: maxSchedulesPerBlock,
+		MaxGasPerBlock:       maxGasPerBlock,
+		MaxGasPerSchedule:    maxGasPerSchedule,
+		MaxMsgsPerSchedule:   maxMsgsPerSchedule,
+		MaxHeightDelay:       maxHeightDelay,
+		MaxTimeDelay:         maxTimeDelay,
+	}
+}
+
+// DefaultParams returns default scheduler module parameters
+func DefaultParams() Params {
+	return NewParams(
+		20,               // schedules per block
+		10000000,         // gas per block
+		1000000,          // gas per schedule
+		10,               // msgs per schedule
+		6307200,          // about one year of 5s blocks
+		365*24*time.Hour, // one year
+	)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxSchedulesPerBlock, &p.MaxSchedulesPerBlock, validateMaxSchedulesPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxGasPerBlock, &p.MaxGasPerBlock, validateMaxGas),
+		paramtypes.NewParamSetPair(KeyMaxGasPerSchedule, &p.MaxGasPerSchedule, validateMaxGas),
+		paramtypes.NewParamSetPair(KeyMaxMsgsPerSchedule, &p.MaxMsgsPerSchedule, validateMaxMsgsPerSchedule),
+		paramtypes.NewParamSetPair(KeyMaxHeightDelay, &p.MaxHeightDelay, validateMaxHeightDelay),
+		paramtypes.NewParamSetPair(KeyMaxTimeDelay, &p.MaxTimeDelay, validateMaxTimeDelay),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateMaxSchedulesPerBlock(p.MaxSchedulesPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxGas(p.MaxGasPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxGas(p.MaxGasPerSchedule); err != nil {
+		return err
+	}
+	if p.MaxGasPerSchedule > p.MaxGasPerBlock {
+		return fmt.Errorf("max gas per schedule %d must not be greater than max gas per block %d", p.MaxGasPerSchedule, p.MaxGasPerBlock)
+	}
+	if err := validateMaxMsgsPerSchedule(p.MaxMsgsPerSchedule); err != nil {
+		return err
+	}
+	if err := validateMaxHeightDelay(p.MaxHeightDelay); err != nil {
+		return err
+	}
+	return validateMaxTimeDelay(p.MaxTimeDelay)
+}
+
+func validateMaxSchedulesPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max schedules per block must be positive")
+	}
+	return nil
+}
+
+func validateMaxGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max gas must be positive")
+	}
+	return nil
+}
+
+func validateMaxMsgsPerSchedule(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max msgs per schedule must be positive")
+	}
+	return nil
+}
+
+func validateMaxHeightDelay(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("max height delay must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateMaxTimeDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("max time delay must be positive: %s", v)
+	}
+	return nil
+}
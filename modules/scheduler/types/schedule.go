@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+var _ codectypes.UnpackInterfacesMessage = Schedule{}
+
+// NewSchedule creates a new Schedule instance
+func NewSchedule(
+	id uint64, creator sdk.AccAddress, msgs []*codectypes.Any, height int64, t *time.Time, gasLimit uint64, fee sdk.Coins,
+) Schedule {
+	return Schedule{
+		Id:       id,
+		Creator:  creator.String(),
+		Msgs:     msgs,
+		Height:   height,
+		Time:     t,
+		GasLimit: gasLimit,
+		Fee:      fee,
+	}
+}
+
+// ValidateBasic performs basic validation on Schedule
+func (s Schedule) ValidateBasic() error {
+	if s.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "schedule id must be positive")
+	}
+	return validateSchedule(s.Creator, s.Msgs, s.Height, s.Time, s.GasLimit, s.Fee)
+}
+
+// GetMessages returns the unpacked msgs of the schedule
+func (s Schedule) GetMessages() ([]sdk.Msg, error) {
+	return msgrouter.UnpackMsgs(s.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s Schedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msgrouter.UnpackInterfaces(unpacker, s.Msgs)
+}
+
+func validateSchedule(
+	creator string, anys []*codectypes.Any, height int64, t *time.Time, gasLimit uint64, fee sdk.Coins,
+) error {
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if (height > 0) == (t != nil) {
+		return sdkerrors.Wrap(ErrInvalidTrigger, "either a height or a time must be set")
+	}
+	if height < 0 {
+		return sdkerrors.Wrapf(ErrInvalidTrigger, "height must not be negative: %d", height)
+	}
+
+	if gasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "gas limit must be positive")
+	}
+	if !fee.IsValid() || fee.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid fee: %s", fee)
+	}
+
+	if len(anys) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsg, "no msgs")
+	}
+	msgs, err := msgrouter.UnpackMsgs(anys)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(creatorAddr) {
+			return sdkerrors.Wrapf(ErrUnauthorized, "msgs must be signed by the creator only, got %v", signers)
+		}
+
+		if _, ok := msg.(*MsgSchedule); ok {
+			return sdkerrors.Wrap(ErrInvalidMsg, "schedules can not be nested")
+		}
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			if _, ok := svcMsg.Request.(*MsgSchedule); ok {
+				return sdkerrors.Wrap(ErrInvalidMsg, "schedules can not be nested")
+			}
+		}
+	}
+
+	return nil
+}
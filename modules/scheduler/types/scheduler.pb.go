@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduler/scheduler.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Schedule defines messages executed on behalf of their signer at a future height or time
+type Schedule struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// msgs are executed in order, the changes of all the msgs are reverted if one of them fails
+	Msgs []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// height at which the msgs are executed, zero if the schedule is triggered by time
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time after which the msgs are executed, nil if the schedule is triggered by height
+	Time *time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// gas_limit defines the maximum gas consumed by the execution of the msgs
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// fee escrowed until the execution, it is paid to the fee collector when the schedule is executed
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8db78ba60fec18, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Schedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Schedule) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *Schedule) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Schedule) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Schedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Schedule) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// Params defines the parameters for the scheduler module
+type Params struct {
+	// max_schedules_per_block defines the maximum number of schedules executed in a block
+	MaxSchedulesPerBlock uint32 `protobuf:"varint,1,opt,name=max_schedules_per_block,json=maxSchedulesPerBlock,proto3" json:"max_schedules_per_block,omitempty" yaml:"max_schedules_per_block"`
+	// max_gas_per_block defines the maximum total gas limit of the schedules executed in a block
+	MaxGasPerBlock uint64 `protobuf:"varint,2,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty" yaml:"max_gas_per_block"`
+	// max_gas_per_schedule defines the maximum gas limit of a schedule
+	MaxGasPerSchedule uint64 `protobuf:"varint,3,opt,name=max_gas_per_schedule,json=maxGasPerSchedule,proto3" json:"max_gas_per_schedule,omitempty" yaml:"max_gas_per_schedule"`
+	// max_msgs_per_schedule defines the maximum number of msgs of a schedule
+	MaxMsgsPerSchedule uint32 `protobuf:"varint,4,opt,name=max_msgs_per_schedule,json=maxMsgsPerSchedule,proto3" json:"max_msgs_per_schedule,omitempty" yaml:"max_msgs_per_schedule"`
+	// max_height_delay defines how many blocks ahead a schedule can be triggered by height
+	MaxHeightDelay int64 `protobuf:"varint,5,opt,name=max_height_delay,json=maxHeightDelay,proto3" json:"max_height_delay,omitempty" yaml:"max_height_delay"`
+	// max_time_delay defines how long ahead a schedule can be triggered by time
+	MaxTimeDelay time.Duration `protobuf:"bytes,6,opt,name=max_time_delay,json=maxTimeDelay,proto3,stdduration" json:"max_time_delay" yaml:"max_time_delay"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8db78ba60fec18, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxSchedulesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxSchedulesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerSchedule() uint64 {
+	if m != nil {
+		return m.MaxGasPerSchedule
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgsPerSchedule() uint32 {
+	if m != nil {
+		return m.MaxMsgsPerSchedule
+	}
+	return 0
+}
+
+func (m *Params) GetMaxHeightDelay() int64 {
+	if m != nil {
+		return m.MaxHeightDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeDelay() time.Duration {
+	if m != nil {
+		return m.MaxTimeDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Schedule)(nil), "irishub.scheduler.Schedule")
+	proto.RegisterType((*Params)(nil), "irishub.scheduler.Params")
+}
+
+func init() { proto.RegisterFile("scheduler/scheduler.proto", fileDescriptor_3d8db78ba60fec18) }
+
+var fileDescriptor_3d8db78ba60fec18 = []byte{
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x41, 0x4f, 0xdb, 0x3e,
+	0x1c, 0x6d, 0xda, 0xfc, 0x0b, 0x98, 0xff, 0x10, 0x58, 0x65, 0xa4, 0x80, 0xe2, 0x2e, 0xa7, 0x5c,
+	0x96, 0x0c, 0xb6, 0x13, 0xb7, 0x65, 0x4c, 0xec, 0xc0, 0x24, 0x14, 0x76, 0xd9, 0xa4, 0xa9, 0x72,
+	0x1a, 0x93, 0x5a, 0xc4, 0x75, 0x15, 0xa7, 0x53, 0x7b, 0xdf, 0x07, 0xe0, 0xc8, 0x71, 0xe7, 0x7d,
+	0x88, 0x9d, 0x39, 0x72, 0xdc, 0x29, 0x4c, 0xf0, 0x0d, 0xf2, 0x09, 0x26, 0x3b, 0x49, 0x53, 0xa8,
+	0x76, 0xaa, 0xfd, 0x7b, 0xcf, 0x2f, 0xcf, 0xbf, 0xdf, 0xab, 0x41, 0x57, 0x0c, 0x86, 0x24, 0x9c,
+	0xc4, 0x24, 0x71, 0xe7, 0x2b, 0x67, 0x9c, 0xf0, 0x94, 0xc3, 0x2d, 0x9a, 0x50, 0x31, 0x9c, 0x04,
+	0xce, 0x1c, 0xd8, 0xed, 0x44, 0x3c, 0xe2, 0x0a, 0x75, 0xe5, 0xaa, 0x20, 0xee, 0x76, 0x23, 0xce,
+	0xa3, 0x98, 0xb8, 0x6a, 0x17, 0x4c, 0x2e, 0x5c, 0x3c, 0x9a, 0x95, 0x90, 0xf9, 0x14, 0x0a, 0x27,
+	0x09, 0x4e, 0x29, 0x1f, 0x95, 0x38, 0x7a, 0x8a, 0xa7, 0x94, 0x11, 0x91, 0x62, 0x36, 0xae, 0x04,
+	0x06, 0x5c, 0x30, 0x2e, 0xdc, 0x00, 0x0b, 0xe2, 0x7e, 0x3b, 0x08, 0x48, 0x8a, 0x0f, 0xdc, 0x01,
+	0xa7, 0xa5, 0x80, 0xf5, 0xab, 0x09, 0x56, 0xcf, 0x4b, 0x7f, 0x70, 0x03, 0x34, 0x69, 0x68, 0x68,
+	0x3d, 0xcd, 0xd6, 0xfd, 0x26, 0x0d, 0xa1, 0x01, 0x56, 0x06, 0x09, 0xc1, 0x29, 0x4f, 0x8c, 0x66,
+	0x4f, 0xb3, 0xd7, 0xfc, 0x6a, 0x0b, 0x6d, 0xa0, 0x33, 0x11, 0x09, 0xa3, 0xd5, 0x6b, 0xd9, 0xeb,
+	0x87, 0x1d, 0xa7, 0xb0, 0xe1, 0x54, 0x36, 0x9c, 0xb7, 0xa3, 0x99, 0xaf, 0x18, 0xf0, 0x39, 0x68,
+	0x0f, 0x09, 0x8d, 0x86, 0xa9, 0xa1, 0xf7, 0x34, 0xbb, 0xe5, 0x97, 0x3b, 0xf8, 0x06, 0xe8, 0xd2,
+	0xab, 0xf1, 0x5f, 0x4f, 0xb3, 0xd7, 0x0f, 0x77, 0x97, 0x14, 0x3e, 0x55, 0x17, 0xf1, 0xf4, 0xab,
+	0x3b, 0xa4, 0xf9, 0x8a, 0x0d, 0x0f, 0xc0, 0x5a, 0x84, 0x45, 0x3f, 0xa6, 0x8c, 0xa6, 0x46, 0x5b,
+	0x1a, 0xf5, 0x3a, 0x79, 0x86, 0x36, 0x67, 0x98, 0xc5, 0x47, 0xd6, 0x1c, 0xb2, 0xfc, 0xd5, 0x08,
+	0x8b, 0x53, 0xb9, 0x84, 0x5f, 0x41, 0xeb, 0x82, 0x10, 0x63, 0x45, 0x39, 0xed, 0x3a, 0x45, 0x3f,
+	0x1c, 0xd9, 0x0f, 0xa7, 0xec, 0x87, 0xf3, 0x8e, 0xd3, 0x91, 0xf7, 0xea, 0x26, 0x43, 0x8d, 0x9f,
+	0x77, 0xc8, 0x8e, 0x68, 0x2a, 0xa7, 0x36, 0xe0, 0xcc, 0x2d, 0x9b, 0x57, 0xfc, 0xbc, 0x14, 0xe1,
+	0xa5, 0x9b, 0xce, 0xc6, 0x44, 0xa8, 0x03, 0xc2, 0x97, 0xba, 0xd6, 0x77, 0x1d, 0xb4, 0xcf, 0x70,
+	0x82, 0x99, 0x80, 0x9f, 0xc1, 0x0e, 0xc3, 0xd3, 0x7e, 0x35, 0x6e, 0xd1, 0x1f, 0x93, 0xa4, 0x1f,
+	0xc4, 0x7c, 0x70, 0xa9, 0x7a, 0xfa, 0xcc, 0xb3, 0xf2, 0x0c, 0x99, 0x85, 0xd5, 0x7f, 0x10, 0x2d,
+	0xbf, 0xc3, 0xf0, 0xb4, 0x1a, 0x88, 0x38, 0x23, 0x89, 0x27, 0xcb, 0xf0, 0x04, 0x6c, 0xc9, 0x13,
+	0x11, 0x5e, 0xe0, 0xaa, 0x99, 0xe8, 0xde, 0x7e, 0x9e, 0x21, 0xa3, 0x16, 0x7d, 0x44, 0xb1, 0xfc,
+	0x0d, 0x86, 0xa7, 0x27, 0xb8, 0x16, 0x3a, 0x03, 0x9d, 0x45, 0x56, 0x65, 0xc1, 0x68, 0x29, 0x2d,
+	0x94, 0x67, 0x68, 0x6f, 0x59, 0xab, 0x62, 0x59, 0xfe, 0xd6, 0x5c, 0x6e, 0x1e, 0x9a, 0x73, 0xb0,
+	0x2d, 0xb9, 0x72, 0xd8, 0x8f, 0x25, 0x75, 0x75, 0xe7, 0x5e, 0x9e, 0xa1, 0xfd, 0x5a, 0x72, 0x89,
+	0x66, 0xf9, 0x90, 0xe1, 0xe9, 0x47, 0x11, 0x3d, 0x12, 0x7d, 0x0f, 0x36, 0x25, 0xbb, 0xc8, 0x4a,
+	0x3f, 0x24, 0x31, 0x9e, 0xa9, 0xa4, 0xb4, 0xbc, 0xbd, 0x3c, 0x43, 0x3b, 0xb5, 0xde, 0x22, 0xa3,
+	0xb8, 0xed, 0x07, 0x55, 0x39, 0x96, 0x05, 0x18, 0x00, 0x59, 0xe9, 0xcb, 0xe8, 0x94, 0x22, 0x6d,
+	0x15, 0xb7, 0xee, 0x52, 0xdc, 0x8e, 0xcb, 0xff, 0x95, 0xf7, 0x42, 0xc6, 0x20, 0xcf, 0xd0, 0x76,
+	0xfd, 0x8d, 0xfa, 0xb8, 0x75, 0x2d, 0xa3, 0xf8, 0x3f, 0xc3, 0x53, 0x19, 0x4f, 0xf5, 0x8d, 0x23,
+	0xfd, 0xfa, 0x07, 0x6a, 0x78, 0xa7, 0x37, 0xf7, 0xa6, 0x76, 0x7b, 0x6f, 0x6a, 0x7f, 0xee, 0x4d,
+	0xed, 0xea, 0xc1, 0x6c, 0xdc, 0x3e, 0x98, 0x8d, 0xdf, 0x0f, 0x66, 0xe3, 0xcb, 0xe1, 0x42, 0x9e,
+	0xe4, 0x8b, 0x30, 0x22, 0xa9, 0x5b, 0xbe, 0x0c, 0x2e, 0xe3, 0x6a, 0xcc, 0xf5, 0xd3, 0x51, 0xe4,
+	0x2b, 0x68, 0x2b, 0x5f, 0xaf, 0xff, 0x0e, 0x00, 0x45, 0x21, 0x71, 0x07, 0x5e, 0x04, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Time != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintScheduler(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTimeDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTimeDelay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintScheduler(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.MaxHeightDelay != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxHeightDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMsgsPerSchedule != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxMsgsPerSchedule))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGasPerSchedule != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxGasPerSchedule))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSchedulesPerBlock != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxSchedulesPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduler(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovScheduler(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovScheduler(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSchedulesPerBlock != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxSchedulesPerBlock))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxGasPerBlock))
+	}
+	if m.MaxGasPerSchedule != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxGasPerSchedule))
+	}
+	if m.MaxMsgsPerSchedule != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxMsgsPerSchedule))
+	}
+	if m.MaxHeightDelay != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxHeightDelay))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTimeDelay)
+	n += 1 + l + sovScheduler(uint64(l))
+	return n
+}
+
+func sovScheduler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduler(x uint64) (n int) {
+	return sovScheduler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchedulesPerBlock", wireType)
+			}
+			m.MaxSchedulesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchedulesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerSchedule", wireType)
+			}
+			m.MaxGasPerSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerSchedule |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerSchedule", wireType)
+			}
+			m.MaxMsgsPerSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerSchedule |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeightDelay", wireType)
+			}
+			m.MaxHeightDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeightDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTimeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduler(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduler
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduler
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduler
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduler        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduler          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduler = fmt.Errorf("proto: unexpected end of group")
+)
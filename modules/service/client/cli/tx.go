@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/service/types"
+)
+
+// NewSubmitSystemServiceProposalTxCmd returns a CLI command handler for creating
+// a system service proposal governance transaction.
+func NewSubmitSystemServiceProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "system-service [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a system service proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add, update and retire system service definitions and bindings
+along with an initial deposit. The proposal details must be supplied via a JSON file.
+
+The existing definitions and bindings can only be replaced with the same author and owner.
+The bindings must not have deposit, and retired definitions have all their bindings disabled.
+
+Example:
+$ %s tx gov submit-proposal system-service <path/to/proposal.json> --deposit=1000iris --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Add the price feed service",
+  "description": "Add the price feed service provided by the oracle module",
+  "definitions": [
+    {
+      "name": "price_feed",
+      "description": "price feed service",
+      "tags": ["oracle"],
+      "author": "iaa1...",
+      "author_description": "oracle module",
+      "schemas": "{\"input\":{\"type\":\"object\"},\"output\":{\"type\":\"object\"}}"
+    }
+  ],
+  "bindings": [
+    {
+      "service_name": "price_feed",
+      "provider": "iaa1...",
+      "deposit": [],
+      "pricing": "{\"price\":\"0uiris\"}",
+      "qos": "1",
+      "options": "{}",
+      "available": true,
+      "disabled_time": "0001-01-01T00:00:00Z",
+      "owner": "iaa1..."
+    }
+  ],
+  "retired_definitions": ["old_price_feed"],
+  "retired_bindings": [
+    {
+      "service_name": "random",
+      "provider": "iaa1..."
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.SystemServiceProposal
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	return cmd
+}
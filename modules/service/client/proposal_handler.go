@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/service/client/cli"
+	"github.com/irisnet/irishub/modules/service/client/rest"
+)
+
+// ProposalHandler is the system service proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewSubmitSystemServiceProposalTxCmd, rest.ProposalRESTHandler)
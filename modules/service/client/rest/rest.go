@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	"github.com/irisnet/irishub/modules/service/types"
+)
+
+// SystemServiceProposalReq defines a system service proposal request body.
+type SystemServiceProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string                           `json:"title" yaml:"title"`
+	Description        string                           `json:"description" yaml:"description"`
+	Definitions        []servicetypes.ServiceDefinition `json:"definitions" yaml:"definitions"`
+	Bindings           []servicetypes.ServiceBinding    `json:"bindings" yaml:"bindings"`
+	RetiredDefinitions []string                         `json:"retired_definitions" yaml:"retired_definitions"`
+	RetiredBindings    []types.ServiceBindingID         `json:"retired_bindings" yaml:"retired_bindings"`
+	Proposer           sdk.AccAddress                   `json:"proposer" yaml:"proposer"`
+	Deposit            sdk.Coins                        `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the system
+// service REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "system_service",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SystemServiceProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSystemServiceProposal(
+			req.Title, req.Description, req.Definitions, req.Bindings, req.RetiredDefinitions, req.RetiredBindings,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/service/types"
+)
+
+// Keeper of the retired service definitions. The new bindings of the retired definitions are
+// rejected, the definitions themselves are kept in the service store for the existing bindings
+type Keeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper returns a system service keeper
+func NewKeeper(key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// SetDefinitionRetired retires or restores the given service definition
+func (k Keeper) SetDefinitionRetired(ctx sdk.Context, serviceName string, retired bool) {
+	store := ctx.KVStore(k.storeKey)
+	if retired {
+		store.Set(types.GetRetiredDefinitionKey(serviceName), []byte{0x01})
+	} else {
+		store.Delete(types.GetRetiredDefinitionKey(serviceName))
+	}
+}
+
+// IsDefinitionRetired returns true if the given service definition is retired
+func (k Keeper) IsDefinitionRetired(ctx sdk.Context, serviceName string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRetiredDefinitionKey(serviceName))
+}
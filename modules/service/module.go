@@ -0,0 +1,28 @@
+package service
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/irisnet/irismod/modules/service"
+
+	"github.com/irisnet/irishub/modules/service/types"
+)
+
+// AppModuleBasic extends the basic service module with the system service proposal
+type AppModuleBasic struct {
+	service.AppModuleBasic
+}
+
+// RegisterLegacyAminoCodec registers the service module types and the system service proposal
+// on the LegacyAmino codec
+func (a AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	a.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the service module interface types and the system service proposal
+func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	a.AppModuleBasic.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+}
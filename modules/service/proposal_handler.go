@@ -0,0 +1,144 @@
+package service
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	servicekeeper "github.com/irisnet/irismod/modules/service/keeper"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	"github.com/irisnet/irishub/modules/service/keeper"
+	"github.com/irisnet/irishub/modules/service/types"
+)
+
+// NewProposalHandler returns a handler for the system service proposals.
+func NewProposalHandler(k servicekeeper.Keeper, rk keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SystemServiceProposal:
+			return handleSystemServiceProposal(ctx, k, rk, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+// handleSystemServiceProposal sets the definitions and bindings of the proposal, then retires
+// the given ones. The existing definitions and bindings can only be replaced with the same
+// author and owner, so that the services of the users are never taken over. The bindings
+// holding a deposit are never replaced, as the deposit would be lost with them. Setting a
+// retired definition again restores it.
+func handleSystemServiceProposal(ctx sdk.Context, k servicekeeper.Keeper, rk keeper.Keeper, p *types.SystemServiceProposal) error {
+	for _, definition := range p.Definitions {
+		if existing, found := k.GetServiceDefinition(ctx, definition.Name); found && existing.Author != definition.Author {
+			return sdkerrors.Wrapf(servicetypes.ErrNotAuthorized, "author of service definition %s not matching", definition.Name)
+		}
+
+		k.SetServiceDefinition(ctx, definition)
+		rk.SetDefinitionRetired(ctx, definition.Name, false)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetSystemDefinition,
+				sdk.NewAttribute(types.AttributeKeyServiceName, definition.Name),
+			),
+		)
+	}
+
+	for _, binding := range p.Bindings {
+		if _, found := k.GetServiceDefinition(ctx, binding.ServiceName); !found {
+			return sdkerrors.Wrap(servicetypes.ErrUnknownServiceDefinition, binding.ServiceName)
+		}
+		if rk.IsDefinitionRetired(ctx, binding.ServiceName) {
+			return sdkerrors.Wrapf(servicetypes.ErrUnknownServiceDefinition, "service definition %s retired", binding.ServiceName)
+		}
+
+		provider, _ := sdk.AccAddressFromBech32(binding.Provider)
+		if existing, found := k.GetServiceBinding(ctx, binding.ServiceName, provider); found {
+			if existing.Owner != binding.Owner {
+				return sdkerrors.Wrapf(servicetypes.ErrNotAuthorized, "owner of service binding %s/%s not matching", binding.ServiceName, binding.Provider)
+			}
+			if !existing.Deposit.IsZero() {
+				return sdkerrors.Wrapf(servicetypes.ErrInvalidDeposit, "service binding %s/%s holds deposit %s", binding.ServiceName, binding.Provider, existing.Deposit)
+			}
+		}
+
+		if err := k.SetServiceBindingForGenesis(ctx, binding); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetSystemBinding,
+				sdk.NewAttribute(types.AttributeKeyServiceName, binding.ServiceName),
+				sdk.NewAttribute(types.AttributeKeyProvider, binding.Provider),
+			),
+		)
+	}
+
+	for _, serviceName := range p.RetiredDefinitions {
+		if _, found := k.GetServiceDefinition(ctx, serviceName); !found {
+			return sdkerrors.Wrap(servicetypes.ErrUnknownServiceDefinition, serviceName)
+		}
+
+		var bindings []servicetypes.ServiceBinding
+		k.IterateServiceBindings(ctx, func(binding servicetypes.ServiceBinding) bool {
+			if binding.ServiceName == serviceName && binding.Available {
+				bindings = append(bindings, binding)
+			}
+			return false
+		})
+
+		for _, binding := range bindings {
+			if err := disableServiceBinding(ctx, k, binding); err != nil {
+				return err
+			}
+		}
+		rk.SetDefinitionRetired(ctx, serviceName, true)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRetireSystemDefinition,
+				sdk.NewAttribute(types.AttributeKeyServiceName, serviceName),
+			),
+		)
+	}
+
+	for _, bindingID := range p.RetiredBindings {
+		provider, _ := sdk.AccAddressFromBech32(bindingID.Provider)
+		binding, found := k.GetServiceBinding(ctx, bindingID.ServiceName, provider)
+		if !found {
+			return sdkerrors.Wrapf(servicetypes.ErrUnknownServiceBinding, "%s/%s", bindingID.ServiceName, bindingID.Provider)
+		}
+
+		if err := disableServiceBinding(ctx, k, binding); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRetireSystemBinding,
+				sdk.NewAttribute(types.AttributeKeyServiceName, bindingID.ServiceName),
+				sdk.NewAttribute(types.AttributeKeyProvider, bindingID.Provider),
+			),
+		)
+	}
+
+	return nil
+}
+
+// disableServiceBinding disables the binding on behalf of its owner
+func disableServiceBinding(ctx sdk.Context, k servicekeeper.Keeper, binding servicetypes.ServiceBinding) error {
+	provider, err := sdk.AccAddressFromBech32(binding.Provider)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(binding.Owner)
+	if err != nil {
+		return err
+	}
+
+	return k.DisableServiceBinding(ctx, binding.ServiceName, provider, owner)
+}
@@ -0,0 +1,189 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	"github.com/irisnet/irishub/modules/service"
+	"github.com/irisnet/irishub/modules/service/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	author   = sdk.AccAddress([]byte("author______________"))
+	provider = sdk.AccAddress([]byte("provider____________"))
+
+	blockTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type ProposalHandlerTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *simapp.SimApp
+	handler govtypes.Handler
+}
+
+func (suite *ProposalHandlerTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	suite.handler = service.NewProposalHandler(app.ServiceKeeper, app.IrisServiceKeeper)
+}
+
+func TestProposalHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalHandlerTestSuite))
+}
+
+func (suite *ProposalHandlerTestSuite) newDefinition(name string, author sdk.AccAddress) servicetypes.ServiceDefinition {
+	return servicetypes.NewServiceDefinition(
+		name, "price feed", []string{"oracle"}, author, "author", servicetypes.OraclePriceSchemas,
+	)
+}
+
+func (suite *ProposalHandlerTestSuite) newBinding(name string, provider sdk.AccAddress) servicetypes.ServiceBinding {
+	oracleBinding, found := suite.app.ServiceKeeper.GetServiceBinding(
+		suite.ctx, servicetypes.OraclePriceServiceName, servicetypes.OraclePriceServiceProvider,
+	)
+	suite.Require().True(found)
+
+	binding := oracleBinding
+	binding.ServiceName = name
+	binding.Provider = provider.String()
+	binding.Owner = provider.String()
+	return binding
+}
+
+func (suite *ProposalHandlerTestSuite) TestAddSystemService() {
+	definition := suite.newDefinition("price_feed", author)
+	binding := suite.newBinding("price_feed", provider)
+	proposal := types.NewSystemServiceProposal(
+		"title", "description",
+		[]servicetypes.ServiceDefinition{definition}, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+
+	storedDefinition, found := suite.app.ServiceKeeper.GetServiceDefinition(suite.ctx, "price_feed")
+	suite.True(found)
+	suite.Equal(definition, storedDefinition)
+
+	storedBinding, found := suite.app.ServiceKeeper.GetServiceBinding(suite.ctx, "price_feed", provider)
+	suite.True(found)
+	suite.True(storedBinding.Available)
+	suite.Equal(provider, suite.app.ServiceKeeper.GetWithdrawAddress(suite.ctx, provider))
+
+	events := suite.ctx.EventManager().Events()
+	suite.Len(events, 2)
+	suite.Equal(types.EventTypeSetSystemDefinition, events[0].Type)
+	suite.Equal(types.EventTypeSetSystemBinding, events[1].Type)
+}
+
+func (suite *ProposalHandlerTestSuite) TestUpdateSystemService() {
+	definition := servicetypes.GenOraclePriceSvcDefinition()
+	definition.Description = "updated"
+	proposal := types.NewSystemServiceProposal(
+		"title", "description", []servicetypes.ServiceDefinition{definition}, nil, nil, nil,
+	)
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+
+	storedDefinition, _ := suite.app.ServiceKeeper.GetServiceDefinition(suite.ctx, definition.Name)
+	suite.Equal("updated", storedDefinition.Description)
+
+	// the existing definition can not be taken over by another author
+	definition = suite.newDefinition(servicetypes.OraclePriceServiceName, author)
+	proposal = types.NewSystemServiceProposal(
+		"title", "description", []servicetypes.ServiceDefinition{definition}, nil, nil, nil,
+	)
+	suite.Error(suite.handler(suite.ctx, proposal))
+
+	// the binding can not be added to an unknown definition
+	binding := suite.newBinding("unknown", provider)
+	proposal = types.NewSystemServiceProposal(
+		"title", "description", nil, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+	suite.Error(suite.handler(suite.ctx, proposal))
+}
+
+func (suite *ProposalHandlerTestSuite) TestRetireSystemService() {
+	bindingID := types.ServiceBindingID{
+		ServiceName: servicetypes.OraclePriceServiceName,
+		Provider:    servicetypes.OraclePriceServiceProvider.String(),
+	}
+	proposal := types.NewSystemServiceProposal("title", "description", nil, nil, nil, []types.ServiceBindingID{bindingID})
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+
+	binding, _ := suite.app.ServiceKeeper.GetServiceBinding(
+		suite.ctx, servicetypes.OraclePriceServiceName, servicetypes.OraclePriceServiceProvider,
+	)
+	suite.False(binding.Available)
+	suite.Equal(blockTime, binding.DisabledTime)
+
+	// the binding is already disabled
+	suite.Error(suite.handler(suite.ctx, proposal))
+
+	// all the bindings of a retired definition are disabled
+	definition := suite.newDefinition("price_feed", author)
+	binding = suite.newBinding("price_feed", provider)
+	binding.Available = true
+	proposal = types.NewSystemServiceProposal(
+		"title", "description",
+		[]servicetypes.ServiceDefinition{definition}, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+
+	proposal = types.NewSystemServiceProposal("title", "description", nil, nil, []string{"price_feed"}, nil)
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+
+	binding, _ = suite.app.ServiceKeeper.GetServiceBinding(suite.ctx, "price_feed", provider)
+	suite.False(binding.Available)
+	suite.True(suite.app.IrisServiceKeeper.IsDefinitionRetired(suite.ctx, "price_feed"))
+
+	// no binding can be added to a retired definition
+	binding = suite.newBinding("price_feed", author)
+	proposal = types.NewSystemServiceProposal(
+		"title", "description", nil, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+	suite.Error(suite.handler(suite.ctx, proposal))
+
+	// setting the definition again restores it
+	proposal = types.NewSystemServiceProposal(
+		"title", "description",
+		[]servicetypes.ServiceDefinition{definition}, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+	suite.False(suite.app.IrisServiceKeeper.IsDefinitionRetired(suite.ctx, "price_feed"))
+}
+
+func (suite *ProposalHandlerTestSuite) TestUpdateBindingWithDeposit() {
+	definition := suite.newDefinition("price_feed", author)
+	binding := suite.newBinding("price_feed", provider)
+	proposal := types.NewSystemServiceProposal(
+		"title", "description",
+		[]servicetypes.ServiceDefinition{definition}, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+	suite.Require().NoError(suite.handler(suite.ctx, proposal))
+
+	// the binding of the user holds a deposit which would be lost by the update
+	userBinding := binding
+	userBinding.Deposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.Require().NoError(suite.app.ServiceKeeper.SetServiceBindingForGenesis(suite.ctx, userBinding))
+
+	proposal = types.NewSystemServiceProposal(
+		"title", "description", nil, []servicetypes.ServiceBinding{binding}, nil, nil,
+	)
+	suite.Error(suite.handler(suite.ctx, proposal))
+
+	stored, _ := suite.app.ServiceKeeper.GetServiceBinding(suite.ctx, "price_feed", provider)
+	suite.Equal(userBinding.Deposit, stored.Deposit)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers the system service proposal on the provided Amino codec.
+// It is used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&SystemServiceProposal{}, "irishub/service/SystemServiceProposal", nil)
+}
+
+// RegisterInterfaces registers the system service proposal as a gov proposal content
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SystemServiceProposal{},
+	)
+}
@@ -0,0 +1,15 @@
+// nolint
+package types
+
+// system service proposal event types
+const (
+	EventTypeSetSystemDefinition    = "set_system_definition"
+	EventTypeSetSystemBinding       = "set_system_binding"
+	EventTypeRetireSystemDefinition = "retire_system_definition"
+	EventTypeRetireSystemBinding    = "retire_system_binding"
+
+	AttributeKeyServiceName = "service_name"
+	AttributeKeyProvider    = "provider"
+
+	AttributeValueCategory = ModuleName
+)
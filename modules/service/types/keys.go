@@ -0,0 +1,26 @@
+package types
+
+import (
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+// nolint
+const (
+	// ModuleName is the name of the service module extended by the system service proposals
+	ModuleName = servicetypes.ModuleName
+
+	// StoreKey is the store key of the retired service definitions, apart from the service store
+	StoreKey = "system_service"
+
+	// RouterKey is the governance route for the system service proposals
+	RouterKey = ModuleName
+)
+
+var (
+	RetiredDefinitionKeyPrefix = []byte{0x01} // retired service definition key prefix
+)
+
+// GetRetiredDefinitionKey returns the key of the retired flag of the given service definition
+func GetRetiredDefinitionKey(serviceName string) []byte {
+	return append(RetiredDefinitionKeyPrefix, []byte(serviceName)...)
+}
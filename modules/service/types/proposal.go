@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+const (
+	// ProposalTypeSystemService defines the type for a SystemServiceProposal
+	ProposalTypeSystemService = "SystemService"
+)
+
+// Implements Proposal Interface
+var _ govtypes.Content = &SystemServiceProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSystemService)
+	govtypes.RegisterProposalTypeCodec(&SystemServiceProposal{}, "irishub/service/SystemServiceProposal")
+}
+
+// NewSystemServiceProposal creates a new system service proposal.
+func NewSystemServiceProposal(
+	title, description string,
+	definitions []servicetypes.ServiceDefinition,
+	bindings []servicetypes.ServiceBinding,
+	retiredDefinitions []string,
+	retiredBindings []ServiceBindingID,
+) *SystemServiceProposal {
+	return &SystemServiceProposal{
+		Title:              title,
+		Description:        description,
+		Definitions:        definitions,
+		Bindings:           bindings,
+		RetiredDefinitions: retiredDefinitions,
+		RetiredBindings:    retiredBindings,
+	}
+}
+
+// GetTitle returns the title of a system service proposal.
+func (sp *SystemServiceProposal) GetTitle() string { return sp.Title }
+
+// GetDescription returns the description of a system service proposal.
+func (sp *SystemServiceProposal) GetDescription() string { return sp.Description }
+
+// ProposalRoute returns the routing key of a system service proposal.
+func (sp *SystemServiceProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a system service proposal.
+func (sp *SystemServiceProposal) ProposalType() string { return ProposalTypeSystemService }
+
+// ValidateBasic runs basic stateless validity checks
+func (sp *SystemServiceProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+
+	if len(sp.Definitions) == 0 && len(sp.Bindings) == 0 &&
+		len(sp.RetiredDefinitions) == 0 && len(sp.RetiredBindings) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no service definition or binding to change")
+	}
+
+	seenDefinitions := make(map[string]bool)
+	for _, definition := range sp.Definitions {
+		if err := definition.Validate(); err != nil {
+			return err
+		}
+		if seenDefinitions[definition.Name] {
+			return sdkerrors.Wrapf(servicetypes.ErrInvalidServiceName, "duplicate service definition: %s", definition.Name)
+		}
+		seenDefinitions[definition.Name] = true
+	}
+	for _, serviceName := range sp.RetiredDefinitions {
+		if err := servicetypes.ValidateServiceName(serviceName); err != nil {
+			return err
+		}
+		if seenDefinitions[serviceName] {
+			return sdkerrors.Wrapf(servicetypes.ErrInvalidServiceName, "duplicate service definition: %s", serviceName)
+		}
+		seenDefinitions[serviceName] = true
+	}
+
+	seenBindings := make(map[string]bool)
+	for _, binding := range sp.Bindings {
+		if err := binding.Validate(); err != nil {
+			return err
+		}
+		// system services are provided without deposit, so that nothing can be refunded
+		if !binding.Deposit.IsZero() {
+			return sdkerrors.Wrapf(servicetypes.ErrInvalidDeposit, "system service binding must not have deposit: %s", binding.Deposit)
+		}
+		if !binding.Available {
+			return sdkerrors.Wrapf(servicetypes.ErrServiceBindingUnavailable, "use retired bindings to disable the binding of %s", binding.ServiceName)
+		}
+		if id := binding.ServiceName + "/" + binding.Provider; seenBindings[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate service binding: %s", id)
+		} else {
+			seenBindings[id] = true
+		}
+	}
+	for _, bindingID := range sp.RetiredBindings {
+		if err := bindingID.Validate(); err != nil {
+			return err
+		}
+		if id := bindingID.ServiceName + "/" + bindingID.Provider; seenBindings[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate service binding: %s", id)
+		} else {
+			seenBindings[id] = true
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sp SystemServiceProposal) String() string {
+	out, _ := yaml.Marshal(sp)
+	return fmt.Sprintf("System Service Proposal:\n%s", out)
+}
+
+// Validate validates the service binding ID
+func (id ServiceBindingID) Validate() error {
+	if err := servicetypes.ValidateServiceName(id.ServiceName); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(id.Provider); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: service/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/irisnet/irismod/modules/service/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SystemServiceProposal struct {
+	Title              string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Definitions        []types.ServiceDefinition `protobuf:"bytes,3,rep,name=definitions,proto3" json:"definitions"`
+	Bindings           []types.ServiceBinding    `protobuf:"bytes,4,rep,name=bindings,proto3" json:"bindings"`
+	RetiredDefinitions []string                  `protobuf:"bytes,5,rep,name=retired_definitions,json=retiredDefinitions,proto3" json:"retired_definitions,omitempty" yaml:"retired_definitions"`
+	RetiredBindings    []ServiceBindingID        `protobuf:"bytes,6,rep,name=retired_bindings,json=retiredBindings,proto3" json:"retired_bindings" yaml:"retired_bindings"`
+}
+
+func (m *SystemServiceProposal) Reset()      { *m = SystemServiceProposal{} }
+func (*SystemServiceProposal) ProtoMessage() {}
+func (*SystemServiceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_558784a3f0443751, []int{0}
+}
+func (m *SystemServiceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SystemServiceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SystemServiceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SystemServiceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemServiceProposal.Merge(m, src)
+}
+func (m *SystemServiceProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SystemServiceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemServiceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemServiceProposal proto.InternalMessageInfo
+
+type ServiceBindingID struct {
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty" yaml:"service_name"`
+	Provider    string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *ServiceBindingID) Reset()         { *m = ServiceBindingID{} }
+func (m *ServiceBindingID) String() string { return proto.CompactTextString(m) }
+func (*ServiceBindingID) ProtoMessage()    {}
+func (*ServiceBindingID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_558784a3f0443751, []int{1}
+}
+func (m *ServiceBindingID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceBindingID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceBindingID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceBindingID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceBindingID.Merge(m, src)
+}
+func (m *ServiceBindingID) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceBindingID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceBindingID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceBindingID proto.InternalMessageInfo
+
+func (m *ServiceBindingID) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *ServiceBindingID) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SystemServiceProposal)(nil), "irishub.service.SystemServiceProposal")
+	proto.RegisterType((*ServiceBindingID)(nil), "irishub.service.ServiceBindingID")
+}
+
+func init() { proto.RegisterFile("service/proposal.proto", fileDescriptor_558784a3f0443751) }
+
+var fileDescriptor_558784a3f0443751 = []byte{
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xb1, 0xae, 0x9b, 0x30,
+	0x14, 0x85, 0xf2, 0xde, 0xd3, 0x7b, 0xce, 0x93, 0x5e, 0xe4, 0x24, 0x0d, 0x62, 0xc0, 0x94, 0x29,
+	0x13, 0x54, 0xed, 0x96, 0xad, 0x28, 0x4b, 0x33, 0xb4, 0x15, 0xd9, 0xba, 0x44, 0x24, 0xb8, 0xd4,
+	0x15, 0xc6, 0xc8, 0x76, 0x22, 0xe5, 0x0f, 0x3a, 0x76, 0xec, 0x98, 0x0f, 0xe8, 0x87, 0x64, 0xcc,
+	0xd8, 0x09, 0x55, 0xc9, 0xd2, 0x39, 0x5f, 0x50, 0x01, 0x06, 0xd1, 0xa8, 0x9d, 0xe0, 0x9e, 0x7b,
+	0xee, 0x39, 0xe7, 0xea, 0x1a, 0x3c, 0x17, 0x98, 0x6f, 0xc9, 0x1a, 0xfb, 0x39, 0x67, 0x39, 0x13,
+	0x51, 0xea, 0xe5, 0x9c, 0x49, 0x06, 0x9f, 0x08, 0x27, 0xe2, 0xf3, 0x66, 0xe5, 0xa9, 0xbe, 0x35,
+	0x4c, 0x58, 0xc2, 0xaa, 0x9e, 0x5f, 0xfe, 0xd5, 0x34, 0x6b, 0xd4, 0x8c, 0xab, 0x6f, 0x0d, 0xbb,
+	0x3f, 0x0c, 0x30, 0x5a, 0xec, 0x84, 0xc4, 0x74, 0x51, 0xe3, 0x1f, 0x94, 0x3a, 0x1c, 0x82, 0x5b,
+	0x49, 0x64, 0x8a, 0x4d, 0xdd, 0xd1, 0x27, 0x0f, 0x61, 0x5d, 0x40, 0x07, 0xf4, 0x62, 0x2c, 0xd6,
+	0x9c, 0xe4, 0x92, 0xb0, 0xcc, 0x7c, 0x56, 0xf5, 0xba, 0x10, 0x9c, 0x97, 0x8c, 0x4f, 0x24, 0x23,
+	0x65, 0x25, 0x4c, 0xc3, 0x31, 0x26, 0xbd, 0x57, 0xae, 0x57, 0xa6, 0xa4, 0x2c, 0x6e, 0x52, 0x7a,
+	0xca, 0x6e, 0xd6, 0x52, 0x83, 0x9b, 0x43, 0x81, 0xb4, 0xb0, 0x3b, 0x0c, 0xdf, 0x80, 0xfb, 0x15,
+	0xc9, 0x62, 0x92, 0x25, 0xc2, 0xbc, 0xa9, 0x84, 0xd0, 0xff, 0x84, 0x82, 0x9a, 0xa7, 0x54, 0xda,
+	0x31, 0xf8, 0x1e, 0x0c, 0x38, 0x96, 0x84, 0xe3, 0x78, 0xd9, 0x8d, 0x75, 0xeb, 0x18, 0x93, 0x87,
+	0xc0, 0xbe, 0x14, 0xc8, 0xda, 0x45, 0x34, 0x9d, 0xba, 0xff, 0x20, 0xb9, 0x21, 0x54, 0xe8, 0xac,
+	0x93, 0x89, 0x82, 0x7e, 0xc3, 0x6d, 0xb3, 0xdd, 0x55, 0xd9, 0x5e, 0x78, 0x57, 0xa7, 0xb8, 0xca,
+	0xf6, 0x76, 0x16, 0xa0, 0x32, 0xdd, 0xa5, 0x40, 0xe3, 0xbf, 0x4d, 0x1b, 0x21, 0x37, 0x7c, 0x52,
+	0x90, 0x1a, 0x11, 0xd3, 0xc7, 0xaf, 0x7b, 0xa4, 0x7d, 0xdf, 0x23, 0xed, 0xf7, 0x1e, 0x69, 0xee,
+	0x17, 0xd0, 0xbf, 0xd6, 0x84, 0x53, 0xf0, 0xa8, 0xfc, 0x96, 0x59, 0x44, 0xd5, 0xbd, 0x82, 0xf1,
+	0xa5, 0x40, 0x83, 0xda, 0xa5, 0xdb, 0x75, 0xc3, 0x9e, 0x2a, 0xdf, 0x45, 0x14, 0x43, 0x0b, 0xdc,
+	0xe7, 0x9c, 0x6d, 0x49, 0x8c, 0xb9, 0xba, 0x65, 0x5b, 0x07, 0xf3, 0xc3, 0xc9, 0xd6, 0x8f, 0x27,
+	0x5b, 0xff, 0x75, 0xb2, 0xf5, 0x6f, 0x67, 0x5b, 0x3b, 0x9e, 0x6d, 0xed, 0xe7, 0xd9, 0xd6, 0x3e,
+	0xbe, 0x4c, 0x88, 0x2c, 0xd7, 0x5c, 0x33, 0xea, 0x97, 0x2b, 0x67, 0x58, 0xfa, 0x6a, 0x75, 0x9f,
+	0xb2, 0x78, 0x93, 0x62, 0xd1, 0x3c, 0x33, 0x5f, 0xee, 0x72, 0x2c, 0x56, 0x77, 0xd5, 0x6b, 0x7b,
+	0xfd, 0x67, 0x00, 0x36, 0xd2, 0x37, 0xe3, 0xc5, 0x02, 0x00, 0x00,
+}
+
+func (m *SystemServiceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SystemServiceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SystemServiceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetiredBindings) > 0 {
+		for iNdEx := len(m.RetiredBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetiredBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RetiredDefinitions) > 0 {
+		for iNdEx := len(m.RetiredDefinitions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetiredDefinitions[iNdEx])
+			copy(dAtA[i:], m.RetiredDefinitions[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.RetiredDefinitions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Definitions) > 0 {
+		for iNdEx := len(m.Definitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Definitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceBindingID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceBindingID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceBindingID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SystemServiceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Definitions) > 0 {
+		for _, e := range m.Definitions {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.RetiredDefinitions) > 0 {
+		for _, s := range m.RetiredDefinitions {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.RetiredBindings) > 0 {
+		for _, e := range m.RetiredBindings {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceBindingID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SystemServiceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemServiceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemServiceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definitions = append(m.Definitions, types.ServiceDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, types.ServiceBinding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredDefinitions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredDefinitions = append(m.RetiredDefinitions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredBindings = append(m.RetiredBindings, ServiceBindingID{})
+			if err := m.RetiredBindings[len(m.RetiredBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceBindingID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceBindingID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceBindingID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
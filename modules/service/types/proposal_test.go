@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+func TestSystemServiceProposalValidateBasic(t *testing.T) {
+	definition := servicetypes.GenOraclePriceSvcDefinition()
+	binding := servicetypes.GenOraclePriceSvcBinding("uiris")
+	bindingID := ServiceBindingID{ServiceName: binding.ServiceName, Provider: binding.Provider}
+
+	invalidDefinition := definition
+	invalidDefinition.Schemas = "{}"
+
+	bindingWithDeposit := binding
+	bindingWithDeposit.Deposit = sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))
+
+	unavailableBinding := binding
+	unavailableBinding.Available = false
+
+	tests := []struct {
+		name     string
+		proposal *SystemServiceProposal
+		wantErr  bool
+	}{
+		{"valid", NewSystemServiceProposal("title", "description", []servicetypes.ServiceDefinition{definition}, []servicetypes.ServiceBinding{binding}, nil, nil), false},
+		{"valid retirement", NewSystemServiceProposal("title", "description", nil, nil, []string{"random"}, []ServiceBindingID{bindingID}), false},
+		{"empty title", NewSystemServiceProposal("", "description", []servicetypes.ServiceDefinition{definition}, nil, nil, nil), true},
+		{"no change", NewSystemServiceProposal("title", "description", nil, nil, nil, nil), true},
+		{"invalid schemas", NewSystemServiceProposal("title", "description", []servicetypes.ServiceDefinition{invalidDefinition}, nil, nil, nil), true},
+		{"duplicate definition", NewSystemServiceProposal("title", "description", []servicetypes.ServiceDefinition{definition}, nil, []string{definition.Name}, nil), true},
+		{"invalid retired definition", NewSystemServiceProposal("title", "description", nil, nil, []string{"0random"}, nil), true},
+		{"binding with deposit", NewSystemServiceProposal("title", "description", nil, []servicetypes.ServiceBinding{bindingWithDeposit}, nil, nil), true},
+		{"unavailable binding", NewSystemServiceProposal("title", "description", nil, []servicetypes.ServiceBinding{unavailableBinding}, nil, nil), true},
+		{"duplicate binding", NewSystemServiceProposal("title", "description", nil, []servicetypes.ServiceBinding{binding}, nil, []ServiceBindingID{bindingID}), true},
+		{"invalid provider", NewSystemServiceProposal("title", "description", nil, nil, nil, []ServiceBindingID{{ServiceName: "random", Provider: "provider"}}), true},
+	}
+
+	for _, tt := range tests {
+		err := tt.proposal.ValidateBasic()
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
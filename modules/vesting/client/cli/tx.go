@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+
+	"github.com/irisnet/irishub/modules/vesting/types"
+)
+
+// NewTxCmd returns the transaction commands for the vesting module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		vestingcli.NewMsgCreateVestingAccountCmd(),
+		GetCmdCreatePeriodicVestingAccount(),
+	)
+	return txCmd
+}
+
+// GetCmdCreatePeriodicVestingAccount implements the create periodic vesting account command.
+func GetCmdCreatePeriodicVestingAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with the amounts of its periods",
+		Long: `Create a new periodic vesting account funded with the total amount of its periods. The start time is
+a unix timestamp and the lengths of the periods are in seconds, each period vests its coins at its end, e.g.
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10000000uiris", "length_seconds": 2592000},
+    {"coins": "10000000uiris", "length_seconds": 2592000}
+  ]
+}`,
+		Example: fmt.Sprintf(
+			"%s tx vesting create-periodic-vesting-account <address> periods.json --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := ParsePeriodsFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), to, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines the periods file, e.g.
+//
+//	{
+//	  "start_time": 1625204910,
+//	  "periods": [
+//	    {"coins": "10000000uiris", "length_seconds": 2592000},
+//	    {"coins": "10000000uiris", "length_seconds": 2592000}
+//	  ]
+//	}
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a vesting period of the periods file
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// ParsePeriodsFile reads the start time and the vesting periods from the periods file
+func ParsePeriodsFile(path string) (startTime int64, periods vestingtypes.Periods, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse the periods file: %w", err)
+	}
+
+	periods = make(vestingtypes.Periods, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to parse the coins of period %d: %w", i, err)
+		}
+		periods[i] = vestingtypes.Period{Length: p.Length, Amount: amount}
+	}
+
+	return data.StartTime, periods, nil
+}
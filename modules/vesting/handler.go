@@ -0,0 +1,35 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/irisnet/irishub/modules/vesting/keeper"
+	"github.com/irisnet/irishub/modules/vesting/types"
+)
+
+// NewHandler returns a handler for all "vesting" type messages, the msgs of the sdk vesting module
+// are handled by its handler
+func NewHandler(ak authkeeper.AccountKeeper, bk types.BankKeeper) sdk.Handler {
+	sdkHandler := sdkvesting.NewHandler(ak, bk)
+	msgServer := keeper.NewMsgServerImpl(ak, bk)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *vestingtypes.MsgCreateVestingAccount:
+			return sdkHandler(ctx, msg)
+
+		case *types.MsgCreatePeriodicVestingAccount:
+			res, err := msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
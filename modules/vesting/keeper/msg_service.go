@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/irisnet/irishub/modules/vesting/types"
+)
+
+type msgServer struct {
+	ak types.AccountKeeper
+	bk types.BankKeeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the irishub vesting MsgServer interface
+// for the provided account and bank keepers.
+func NewMsgServerImpl(ak types.AccountKeeper, bk types.BankKeeper) types.MsgServer {
+	return &msgServer{ak: ak, bk: bk}
+}
+
+// CreatePeriodicVestingAccount creates a periodic vesting account funded with the total amount of its periods
+func (m msgServer) CreatePeriodicVestingAccount(
+	goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount,
+) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := msg.GetTotalAmount()
+	if err := m.bk.SendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if m.bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+	if acc := m.ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	newAccount := m.ak.NewAccountWithAddress(ctx, to)
+	baseAccount, ok := newAccount.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", newAccount)
+	}
+
+	account := vestingtypes.NewPeriodicVestingAccount(baseAccount, amount, msg.StartTime, msg.VestingPeriods)
+	m.ak.SetAccount(ctx, account)
+
+	if err := m.bk.SendCoins(ctx, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress),
+		),
+	)
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/irisnet/irishub/modules/vesting/keeper"
+	"github.com/irisnet/irishub/modules/vesting/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	from = sdk.AccAddress([]byte("from________________"))
+	to   = sdk.AccAddress([]byte("to__________________"))
+
+	blockTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	month     = int64(30 * 24 * 3600)
+	amount    = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	app       *simapp.SimApp
+}
+
+func (suite *MsgServerTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: blockTime})
+	suite.msgServer = keeper.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper)
+
+	suite.NoError(app.BankKeeper.SetBalances(suite.ctx, from, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
+
+func (suite *MsgServerTestSuite) TestCreatePeriodicVestingAccount() {
+	periods := vestingtypes.Periods{{Length: month, Amount: amount}, {Length: month, Amount: amount}}
+	msg := types.NewMsgCreatePeriodicVestingAccount(from, to, blockTime.Unix(), periods)
+
+	_, err := suite.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.NoError(err)
+
+	account, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, to).(*vestingtypes.PeriodicVestingAccount)
+	suite.True(ok)
+	suite.Equal(amount.Add(amount...), account.OriginalVesting)
+	suite.Equal(blockTime.Unix()+2*month, account.EndTime)
+	suite.Equal(amount.Add(amount...), suite.app.BankKeeper.GetAllBalances(suite.ctx, to))
+
+	// the coins of the first period are spendable after its cliff only
+	suite.True(suite.app.BankKeeper.SpendableCoins(suite.ctx, to).IsZero())
+	afterCliff := suite.ctx.WithBlockTime(blockTime.Add(time.Duration(month) * time.Second))
+	suite.Equal(amount, suite.app.BankKeeper.SpendableCoins(afterCliff, to))
+
+	// the account can not be created twice
+	_, err = suite.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Error(err)
+
+	// the total amount can not exceed the balance of the sender
+	periods = vestingtypes.Periods{{Length: month, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5000))}}
+	msg = types.NewMsgCreatePeriodicVestingAccount(from, sdk.AccAddress([]byte("other_______________")), blockTime.Unix(), periods)
+	_, err = suite.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Error(err)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// RegisterLegacyAminoCodec registers the vesting accounts of the sdk and the
+// concrete types of the module on the provided Amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	vestingtypes.RegisterLegacyAminoCodec(cdc)
+	cdc.RegisterConcrete(&MsgCreatePeriodicVestingAccount{}, "irishub/vesting/MsgCreatePeriodicVestingAccount", nil)
+}
+
+// RegisterInterfaces registers the vesting accounts and msgs of the sdk and the
+// implementations of the module
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	vestingtypes.RegisterInterfaces(registry)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePeriodicVestingAccount{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// nolint
+const (
+	// module name, the module supersedes the vesting module of the sdk
+	ModuleName = vestingtypes.ModuleName
+
+	// RouterKey is the message route for vesting
+	RouterKey = ModuleName
+
+	// AttributeValueCategory is an alias for the message event value
+	AttributeValueCategory = ModuleName
+)
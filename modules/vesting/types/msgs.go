@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	TypeMsgCreatePeriodicVestingAccount = "create_periodic_vesting_account" // type for MsgCreatePeriodicVestingAccount
+)
+
+var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+
+// NewMsgCreatePeriodicVestingAccount constructs a MsgCreatePeriodicVestingAccount
+func NewMsgCreatePeriodicVestingAccount(
+	from, to sdk.AccAddress, startTime int64, periods vestingtypes.Periods,
+) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:    from.String(),
+		ToAddress:      to.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) Type() string { return TypeMsgCreatePeriodicVestingAccount }
+
+// GetSignBytes implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.StartTime < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time %d, must be positive", msg.StartTime)
+	}
+	return ValidatePeriods(msg.VestingPeriods)
+}
+
+// GetSigners implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetTotalAmount returns the sum of the amounts of the vesting periods
+func (msg MsgCreatePeriodicVestingAccount) GetTotalAmount() sdk.Coins {
+	return TotalAmount(msg.VestingPeriods)
+}
+
+// ValidatePeriods checks that there is at least one period and that the periods
+// have positive lengths and amounts
+func ValidatePeriods(periods vestingtypes.Periods) error {
+	if len(periods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no vesting periods")
+	}
+	for i, period := range periods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid length %d of period %d, must be positive", period.Length, i)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s of period %d", period.Amount, i)
+		}
+	}
+	return nil
+}
+
+// TotalAmount returns the sum of the amounts of the vesting periods
+func TotalAmount(periods vestingtypes.Periods) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range periods {
+		total = total.Add(period.Amount...)
+	}
+	return total
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	from = sdk.AccAddress([]byte("from________________"))
+	to   = sdk.AccAddress([]byte("to__________________"))
+
+	coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+func TestMsgCreatePeriodicVestingAccountValidation(t *testing.T) {
+	month := int64(30 * 24 * 3600)
+	periods := vestingtypes.Periods{{Length: month, Amount: coins}, {Length: month, Amount: coins}}
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgCreatePeriodicVestingAccount
+	}{
+		{"pass", true, NewMsgCreatePeriodicVestingAccount(from, to, 1625204910, periods)},
+		{"invalid recipient", false, NewMsgCreatePeriodicVestingAccount(from, sdk.AccAddress{}, 1625204910, periods)},
+		{"no start time", false, NewMsgCreatePeriodicVestingAccount(from, to, 0, periods)},
+		{"no periods", false, NewMsgCreatePeriodicVestingAccount(from, to, 1625204910, nil)},
+		{"zero length", false, NewMsgCreatePeriodicVestingAccount(from, to, 1625204910, vestingtypes.Periods{{Length: 0, Amount: coins}})},
+		{"no amount", false, NewMsgCreatePeriodicVestingAccount(from, to, 1625204910, vestingtypes.Periods{{Length: month}})},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Equal(t, coins.Add(coins...), NewMsgCreatePeriodicVestingAccount(from, to, 1625204910, periods).GetTotalAmount())
+}
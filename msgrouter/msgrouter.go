@@ -0,0 +1,145 @@
+// Package msgrouter packs the msgs executed by the modules on behalf of their signers and routes them
+// to the Msg services. The msgs are packed the way the msgs of txs are, so that both the legacy msgs
+// and the service msgs of ADR-031 are supported
+package msgrouter
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// serviceMsgInterfaceName is the name of the interface the requests of the Msg services are registered under
+const serviceMsgInterfaceName = "cosmos.base.v1beta1.ServiceMsg"
+
+// PackMsgs packs the msgs into Anys, the service msgs are packed with their method name as type url
+func PackMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		switch msg := msg.(type) {
+		case sdk.ServiceMsg:
+			anys[i], err = codectypes.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		default:
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return anys, nil
+}
+
+// UnpackMsgs returns the msgs packed by PackMsgs, the Anys must have been unpacked by UnpackInterfaces
+func UnpackMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		if IsServiceMsg(any.TypeUrl) {
+			req, ok := any.GetCachedValue().(sdk.MsgRequest)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnpackAny, "cannot unpack service msg %s", any.TypeUrl)
+			}
+			msgs[i] = sdk.ServiceMsg{MethodName: any.TypeUrl, Request: req}
+			continue
+		}
+
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnpackAny, "cannot unpack msg %s", any.TypeUrl)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces unpacks the Anys packed by PackMsgs
+func UnpackInterfaces(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		if IsServiceMsg(any.TypeUrl) {
+			var req sdk.MsgRequest
+			if err := unpacker.UnpackAny(any, &req); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsServiceMsg returns true if the type url is the method name of a Msg service as per ADR-031
+func IsServiceMsg(typeURL string) bool {
+	return strings.Count(typeURL, "/") >= 2
+}
+
+// Request returns the request of the service msg, or the msg itself if it is a legacy msg
+func Request(msg sdk.Msg) sdk.Msg {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		if req, ok := svcMsg.Request.(sdk.Msg); ok {
+			return req
+		}
+	}
+	return msg
+}
+
+// TypeURL returns the type url of the msg, which is the type url of the request for the service msgs
+func TypeURL(msg sdk.Msg) string {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		return "/" + proto.MessageName(svcMsg.Request)
+	}
+	return "/" + proto.MessageName(msg)
+}
+
+// Router routes the msgs to the handlers of the Msg service router. The legacy msgs are routed to
+// the Msg service methods taking them as requests
+type Router struct {
+	router *baseapp.MsgServiceRouter
+	// methods maps the request type names of the Msg services to their method names
+	methods map[string]string
+}
+
+// NewRouter returns a Router of the Msg services registered in the interface registry
+func NewRouter(router *baseapp.MsgServiceRouter, registry codectypes.InterfaceRegistry) Router {
+	methods := make(map[string]string)
+	for _, method := range registry.ListImplementations(serviceMsgInterfaceName) {
+		msg, err := registry.Resolve(method)
+		if err != nil {
+			panic(err)
+		}
+		methods[proto.MessageName(msg)] = method
+	}
+
+	return Router{
+		router:  router,
+		methods: methods,
+	}
+}
+
+// Route returns the Msg service handler and the request of the msg
+func (r Router) Route(msg sdk.Msg) (baseapp.MsgServiceHandler, sdk.MsgRequest, error) {
+	var (
+		method string
+		req    sdk.MsgRequest
+	)
+
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		method, req = svcMsg.MethodName, svcMsg.Request
+	} else {
+		method, req = r.methods[proto.MessageName(msg)], msg
+	}
+
+	handler := r.router.Handler(method)
+	if handler == nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no Msg service method found for %s", proto.MessageName(req))
+	}
+	return handler, req, nil
+}
@@ -0,0 +1,30 @@
+package msgrouter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPackMsgs(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	svcMsg := sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: send}
+
+	anys, err := PackMsgs([]sdk.Msg{send, svcMsg})
+	require.NoError(t, err)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", anys[0].TypeUrl)
+	require.Equal(t, "/cosmos.bank.v1beta1.Msg/Send", anys[1].TypeUrl)
+
+	msgs, err := UnpackMsgs(anys)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send, svcMsg}, msgs)
+
+	require.Equal(t, send, Request(svcMsg))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", TypeURL(svcMsg))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", TypeURL(send))
+}
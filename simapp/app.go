@@ -38,7 +38,6 @@ import (
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -110,12 +109,36 @@ import (
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
 
+	"github.com/irisnet/irishub/modules/authz"
+	authzkeeper "github.com/irisnet/irishub/modules/authz/keeper"
+	authztypes "github.com/irisnet/irishub/modules/authz/types"
+	"github.com/irisnet/irishub/modules/feegrant"
+	feegrantkeeper "github.com/irisnet/irishub/modules/feegrant/keeper"
+	feegranttypes "github.com/irisnet/irishub/modules/feegrant/types"
 	"github.com/irisnet/irishub/modules/guardian"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/modules/memo"
+	memokeeper "github.com/irisnet/irishub/modules/memo/keeper"
+	memotypes "github.com/irisnet/irishub/modules/memo/types"
 	"github.com/irisnet/irishub/modules/mint"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/nfttransfer"
+	nfttransferkeeper "github.com/irisnet/irishub/modules/nfttransfer/keeper"
+	nfttransfertypes "github.com/irisnet/irishub/modules/nfttransfer/types"
+	"github.com/irisnet/irishub/modules/ratelimit"
+	ratelimitclient "github.com/irisnet/irishub/modules/ratelimit/client"
+	ratelimitkeeper "github.com/irisnet/irishub/modules/ratelimit/keeper"
+	ratelimittypes "github.com/irisnet/irishub/modules/ratelimit/types"
+	"github.com/irisnet/irishub/modules/scheduler"
+	schedulerkeeper "github.com/irisnet/irishub/modules/scheduler/keeper"
+	schedulertypes "github.com/irisnet/irishub/modules/scheduler/types"
+	irisservice "github.com/irisnet/irishub/modules/service"
+	irisserviceclient "github.com/irisnet/irishub/modules/service/client"
+	irisservicekeeper "github.com/irisnet/irishub/modules/service/keeper"
+	irisservicetypes "github.com/irisnet/irishub/modules/service/types"
+	"github.com/irisnet/irishub/modules/vesting"
 )
 
 const appName = "SimApp"
@@ -137,6 +160,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			ratelimitclient.ProposalHandler, irisserviceclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -147,12 +171,18 @@ var (
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		guardian.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		memo.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		scheduler.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nft.AppModuleBasic{},
 		htlc.AppModuleBasic{},
 		coinswap.AppModuleBasic{},
-		service.AppModuleBasic{},
+		irisservice.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		random.AppModuleBasic{},
 	)
@@ -172,6 +202,7 @@ var (
 		servicetypes.DepositAccName:    {authtypes.Burner},
 		servicetypes.RequestAccName:    nil,
 		servicetypes.TaxAccName:        {authtypes.Burner},
+		schedulertypes.ModuleName:      nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -215,19 +246,27 @@ type SimApp struct {
 	TransferKeeper   ibctransferkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
-
-	GuardianKeeper guardiankeeper.Keeper
-	TokenKeeper    tokenkeeper.Keeper
-	RecordKeeper   recordkeeper.Keeper
-	NFTKeeper      nftkeeper.Keeper
-	HTLCKeeper     htlckeeper.Keeper
-	CoinswapKeeper coinswapkeeper.Keeper
-	ServiceKeeper  servicekeeper.Keeper
-	OracleKeeper   oracleKeeper.Keeper
-	RandomKeeper   randomkeeper.Keeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCMockKeeper     capabilitykeeper.ScopedKeeper
+
+	GuardianKeeper    guardiankeeper.Keeper
+	FeeGrantKeeper    feegrantkeeper.Keeper
+	MemoKeeper        memokeeper.Keeper
+	RateLimitKeeper   ratelimitkeeper.Keeper
+	SchedulerKeeper   schedulerkeeper.Keeper
+	AuthzKeeper       authzkeeper.Keeper
+	NFTTransferKeeper nfttransferkeeper.Keeper
+	TokenKeeper       tokenkeeper.Keeper
+	RecordKeeper      recordkeeper.Keeper
+	NFTKeeper         nftkeeper.Keeper
+	HTLCKeeper        htlckeeper.Keeper
+	CoinswapKeeper    coinswapkeeper.Keeper
+	ServiceKeeper     servicekeeper.Keeper
+	IrisServiceKeeper irisservicekeeper.Keeper
+	OracleKeeper      oracleKeeper.Keeper
+	RandomKeeper      randomkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -267,6 +306,8 @@ func NewSimApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
+		feegranttypes.StoreKey, memotypes.StoreKey, ratelimittypes.StoreKey, nfttransfertypes.StoreKey,
+		schedulertypes.StoreKey, authztypes.StoreKey, irisservicetypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -291,6 +332,7 @@ func NewSimApp(
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedNFTTransferKeeper := app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
 	scopedIBCMockKeeper := app.CapabilityKeeper.ScopeToModule(ibcmock.ModuleName)
@@ -305,9 +347,13 @@ func NewSimApp(
 	StakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
+	app.TokenKeeper = tokenkeeper.NewKeeper(
+		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
+		app.BankKeeper, authtypes.FeeCollectorName,
+	)
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, app.TokenKeeper, authtypes.FeeCollectorName,
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -332,33 +378,56 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, scopedIBCKeeper,
 	)
 
+	app.GuardianKeeper = guardiankeeper.NewKeeper(
+		appCodec, keys[guardiantypes.StoreKey], app.GetSubspace(guardiantypes.ModuleName),
+	)
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(appCodec, keys[ratelimittypes.StoreKey], app.GuardianKeeper)
+
+	app.ServiceKeeper = servicekeeper.NewKeeper(
+		appCodec, keys[servicetypes.StoreKey], app.AccountKeeper, app.BankKeeper,
+		app.GetSubspace(servicetypes.ModuleName), servicetypes.TaxAccName,
+	)
+	app.IrisServiceKeeper = irisservicekeeper.NewKeeper(keys[irisservicetypes.StoreKey])
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ratelimittypes.RouterKey, ratelimit.NewProposalHandler(app.RateLimitKeeper)).
+		AddRoute(irisservicetypes.RouterKey, irisservice.NewProposalHandler(app.ServiceKeeper, app.IrisServiceKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&StakingKeeper, govRouter,
 	)
 
-	// Create Transfer Keepers
+	app.NFTKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
+
+	// Create Transfer Keepers, the outflow quotas are enforced on the sent packets
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		ratelimit.NewICS4Wrapper(app.IBCKeeper.ChannelKeeper, app.RateLimitKeeper), &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.NFTKeeper, scopedNFTTransferKeeper,
+	)
+	nftTransferModule := nfttransfer.NewAppModule(appCodec, app.NFTTransferKeeper)
+
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
 	mockModule := ibcmock.NewAppModule(scopedIBCMockKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add the transfer route wrapped by the rate limits and the nft transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ratelimit.NewIBCModule(transferModule, app.RateLimitKeeper))
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nftTransferModule)
 	ibcRouter.AddRoute(ibcmock.ModuleName, mockModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -369,15 +438,10 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.GuardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
-	app.TokenKeeper = tokenkeeper.NewKeeper(
-		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
-		app.BankKeeper, authtypes.FeeCollectorName,
-	)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegranttypes.StoreKey], app.AccountKeeper)
+	app.MemoKeeper = memokeeper.NewKeeper(appCodec, keys[memotypes.StoreKey])
 	app.RecordKeeper = recordkeeper.NewKeeper(appCodec, keys[recordtypes.StoreKey])
 
-	app.NFTKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
-
 	app.HTLCKeeper = htlckeeper.NewKeeper(appCodec, keys[htlctypes.StoreKey], app.AccountKeeper, app.BankKeeper)
 
 	app.CoinswapKeeper = coinswapkeeper.NewKeeper(
@@ -385,11 +449,6 @@ func NewSimApp(
 		app.BankKeeper, app.AccountKeeper,
 	)
 
-	app.ServiceKeeper = servicekeeper.NewKeeper(
-		appCodec, keys[servicetypes.StoreKey], app.AccountKeeper, app.BankKeeper,
-		app.GetSubspace(servicetypes.ModuleName), servicetypes.TaxAccName,
-	)
-
 	app.OracleKeeper = oracleKeeper.NewKeeper(
 		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
 		app.ServiceKeeper,
@@ -397,6 +456,13 @@ func NewSimApp(
 
 	app.RandomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.BankKeeper, app.ServiceKeeper)
 
+	app.SchedulerKeeper = schedulerkeeper.NewKeeper(
+		appCodec, keys[schedulertypes.StoreKey], app.GetSubspace(schedulertypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), interfaceRegistry, authtypes.FeeCollectorName,
+	)
+
+	app.AuthzKeeper = authzkeeper.NewKeeper(appCodec, keys[authztypes.StoreKey], app.MsgServiceRouter(), interfaceRegistry)
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -426,6 +492,12 @@ func NewSimApp(
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		feegrant.NewAppModule(appCodec, app.FeeGrantKeeper),
+		memo.NewAppModule(appCodec, app.MemoKeeper),
+		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+		scheduler.NewAppModule(appCodec, app.SchedulerKeeper),
+		authz.NewAppModule(appCodec, app.AuthzKeeper),
+		nftTransferModule,
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -443,7 +515,7 @@ func NewSimApp(
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
 		slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName,
-		ibchost.ModuleName, htlctypes.ModuleName, randomtypes.ModuleName,
+		ibchost.ModuleName, htlctypes.ModuleName, randomtypes.ModuleName, schedulertypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
@@ -457,10 +529,12 @@ func NewSimApp(
 	// can do so safely.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
-		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
+		slashingtypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		guardiantypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
+		guardiantypes.ModuleName, tokentypes.ModuleName, minttypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
 		coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
+		feegranttypes.ModuleName, memotypes.ModuleName, ratelimittypes.ModuleName, nfttransfertypes.ModuleName,
+		schedulertypes.ModuleName, authztypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -488,6 +562,12 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
 		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		feegrant.NewAppModule(appCodec, app.FeeGrantKeeper),
+		memo.NewAppModule(appCodec, app.MemoKeeper),
+		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+		scheduler.NewAppModule(appCodec, app.SchedulerKeeper),
+		authz.NewAppModule(appCodec, app.AuthzKeeper),
+		nftTransferModule,
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -535,6 +615,7 @@ func NewSimApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
 
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
@@ -728,7 +809,9 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(htlctypes.ModuleName)
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(schedulertypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -0,0 +1,7 @@
+/*
+Package ibctesting is a copy of the IBC testing package of the cosmos-sdk running the
+irishub simapp, so that the IBC applications of irishub can be tested between chains.
+
+See the cosmos-sdk x/ibc/testing package for the documentation of its usage.
+*/
+package ibctesting
@@ -0,0 +1,101 @@
+package sqlsink
+
+// Schema is the schema of the sink database. The rows of a block are replaced if the block is
+// written again, e.g. when the node replays the last block on restart.
+//
+// The events of the blocks are stored in the generic events and attributes tables, where source
+// is one of begin_block, tx or end_block and tx_index is null for the block events. The events of
+// the irishub modules and the bank transfers are also stored in typed tables.
+const Schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height   INTEGER PRIMARY KEY,
+	hash     TEXT NOT NULL,
+	chain_id TEXT NOT NULL,
+	time     TIMESTAMP NOT NULL,
+	proposer TEXT NOT NULL,
+	num_txs  INTEGER NOT NULL,
+	app_hash TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS txs (
+	height     INTEGER NOT NULL,
+	tx_index   INTEGER NOT NULL,
+	hash       TEXT NOT NULL,
+	code       INTEGER NOT NULL,
+	codespace  TEXT NOT NULL,
+	log        TEXT NOT NULL,
+	gas_wanted INTEGER NOT NULL,
+	gas_used   INTEGER NOT NULL,
+	memo       TEXT NOT NULL,
+	fee        TEXT NOT NULL,
+	PRIMARY KEY (height, tx_index)
+);
+CREATE INDEX IF NOT EXISTS txs_hash ON txs (hash);
+
+CREATE TABLE IF NOT EXISTS messages (
+	height    INTEGER NOT NULL,
+	tx_index  INTEGER NOT NULL,
+	msg_index INTEGER NOT NULL,
+	type_url  TEXT NOT NULL,
+	body      TEXT NOT NULL,
+	PRIMARY KEY (height, tx_index, msg_index)
+);
+CREATE INDEX IF NOT EXISTS messages_type_url ON messages (type_url);
+
+CREATE TABLE IF NOT EXISTS events (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	height      INTEGER NOT NULL,
+	source      TEXT NOT NULL,
+	tx_index    INTEGER,
+	event_index INTEGER NOT NULL,
+	type        TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS events_height ON events (height);
+CREATE INDEX IF NOT EXISTS events_type ON events (type);
+
+CREATE TABLE IF NOT EXISTS attributes (
+	event_id INTEGER NOT NULL REFERENCES events (id),
+	height   INTEGER NOT NULL,
+	key      TEXT NOT NULL,
+	value    TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS attributes_event_id ON attributes (event_id);
+CREATE INDEX IF NOT EXISTS attributes_height ON attributes (height);
+CREATE INDEX IF NOT EXISTS attributes_key_value ON attributes (key, value);
+
+CREATE TABLE IF NOT EXISTS transfers (
+	height    INTEGER NOT NULL,
+	source    TEXT NOT NULL,
+	tx_index  INTEGER,
+	sender    TEXT NOT NULL,
+	recipient TEXT NOT NULL,
+	amount    TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS transfers_height ON transfers (height);
+CREATE INDEX IF NOT EXISTS transfers_sender ON transfers (sender);
+CREATE INDEX IF NOT EXISTS transfers_recipient ON transfers (recipient);
+
+CREATE TABLE IF NOT EXISTS mint_provisions (
+	height         INTEGER NOT NULL,
+	denom          TEXT NOT NULL,
+	amount         TEXT NOT NULL,
+	recipient      TEXT NOT NULL,
+	inflation_time TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS mint_provisions_height ON mint_provisions (height);
+
+CREATE TABLE IF NOT EXISTS guardian_changes (
+	height   INTEGER NOT NULL,
+	source   TEXT NOT NULL,
+	tx_index INTEGER,
+	action   TEXT NOT NULL,
+	address  TEXT NOT NULL,
+	operator TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS guardian_changes_height ON guardian_changes (height);
+`
+
+// tables are the tables whose rows are replaced when a block is written again
+var tables = []string{
+	"blocks", "txs", "messages", "events", "attributes", "transfers", "mint_provisions", "guardian_changes",
+}
@@ -0,0 +1,269 @@
+package sqlsink
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	// register the sqlite3 driver
+	_ "github.com/mattn/go-sqlite3"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/msgrouter"
+)
+
+// TimeFormat is the format of the block times, it is fixed in size so that the times can be compared as strings
+const TimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+// event sources
+const (
+	SourceBeginBlock = "begin_block"
+	SourceTx         = "tx"
+	SourceEndBlock   = "end_block"
+)
+
+// Sink writes the blocks, txs, messages and events of the committed blocks into an SQLite database
+type Sink struct {
+	mtx sync.Mutex
+
+	db        *sql.DB
+	txDecoder sdk.TxDecoder
+	cdc       codec.JSONMarshaler
+
+	beginBlock abci.RequestBeginBlock
+	events     map[string][]abci.Event
+	txs        []deliveredTx
+}
+
+type deliveredTx struct {
+	req abci.RequestDeliverTx
+	res abci.ResponseDeliverTx
+}
+
+// NewSink opens the SQLite database at the given path and creates the schema if needed
+func NewSink(path string, txDecoder sdk.TxDecoder, cdc codec.JSONMarshaler) (*Sink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	// the WAL journal mode allows reading the database while the node writes to it
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", path))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(Schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create the schema: %w", err)
+	}
+
+	return &Sink{
+		db:        db,
+		txDecoder: txDecoder,
+		cdc:       cdc,
+		events:    make(map[string][]abci.Event),
+	}, nil
+}
+
+// Close closes the database
+func (s *Sink) Close() error {
+	return s.db.Close()
+}
+
+// ListenBeginBlock records the block header and the events of BeginBlock
+func (s *Sink) ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.beginBlock = req
+	s.events[SourceBeginBlock] = res.Events
+}
+
+// ListenDeliverTx records the tx and its result
+func (s *Sink) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.txs = append(s.txs, deliveredTx{req: req, res: res})
+}
+
+// ListenEndBlock records the events of EndBlock
+func (s *Sink) ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.events[SourceEndBlock] = res.Events
+}
+
+// ListenCommit writes the recorded block into the database in a single transaction
+func (s *Sink) ListenCommit(res abci.ResponseCommit) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	defer func() {
+		s.beginBlock = abci.RequestBeginBlock{}
+		s.events = make(map[string][]abci.Event)
+		s.txs = nil
+	}()
+
+	// nothing is written for a commit without a block, e.g. the commit of the genesis state in the tests
+	if s.beginBlock.Header.Height == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := s.writeBlock(tx, res); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Sink) writeBlock(tx *sql.Tx, res abci.ResponseCommit) error {
+	header := s.beginBlock.Header
+	height := header.Height
+
+	for _, table := range tables {
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE height = ?", table), height); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO blocks (height, hash, chain_id, time, proposer, num_txs, app_hash) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		height, fmt.Sprintf("%X", s.beginBlock.Hash), header.ChainID, header.Time.UTC().Format(TimeFormat),
+		sdk.ConsAddress(header.ProposerAddress).String(), len(s.txs), fmt.Sprintf("%X", res.Data),
+	); err != nil {
+		return err
+	}
+
+	if err := s.writeEvents(tx, height, SourceBeginBlock, nil, s.events[SourceBeginBlock]); err != nil {
+		return err
+	}
+
+	for i, dtx := range s.txs {
+		if err := s.writeTx(tx, height, i, dtx); err != nil {
+			return err
+		}
+	}
+
+	return s.writeEvents(tx, height, SourceEndBlock, nil, s.events[SourceEndBlock])
+}
+
+func (s *Sink) writeTx(tx *sql.Tx, height int64, index int, dtx deliveredTx) error {
+	var (
+		memo string
+		fee  string
+		msgs []sdk.Msg
+	)
+
+	// the undecodable txs are written without memo, fee and messages
+	if decoded, err := s.txDecoder(dtx.req.Tx); err == nil {
+		msgs = decoded.GetMsgs()
+		if memoTx, ok := decoded.(sdk.TxWithMemo); ok {
+			memo = memoTx.GetMemo()
+		}
+		if feeTx, ok := decoded.(sdk.FeeTx); ok {
+			fee = feeTx.GetFee().String()
+		}
+	}
+
+	if _, err := tx.Exec(
+		`INSERT INTO txs (height, tx_index, hash, code, codespace, log, gas_wanted, gas_used, memo, fee)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		height, index, fmt.Sprintf("%X", tmhash.Sum(dtx.req.Tx)), dtx.res.Code, dtx.res.Codespace,
+		dtx.res.Log, dtx.res.GasWanted, dtx.res.GasUsed, memo, fee,
+	); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		body, err := s.cdc.MarshalJSON(msg)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(
+			"INSERT INTO messages (height, tx_index, msg_index, type_url, body) VALUES (?, ?, ?, ?, ?)",
+			height, index, i, msgrouter.TypeURL(msg), string(body),
+		); err != nil {
+			return err
+		}
+	}
+
+	return s.writeEvents(tx, height, SourceTx, &index, dtx.res.Events)
+}
+
+func (s *Sink) writeEvents(tx *sql.Tx, height int64, source string, txIndex *int, events []abci.Event) error {
+	for i, event := range events {
+		result, err := tx.Exec(
+			"INSERT INTO events (height, source, tx_index, event_index, type) VALUES (?, ?, ?, ?, ?)",
+			height, source, txIndex, i, event.Type,
+		)
+		if err != nil {
+			return err
+		}
+		eventID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+			if _, err := tx.Exec(
+				"INSERT INTO attributes (event_id, height, key, value) VALUES (?, ?, ?, ?)",
+				eventID, height, string(attr.Key), string(attr.Value),
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := writeTypedEvent(tx, height, source, txIndex, event.Type, attrs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTypedEvent writes the events of the irishub modules and the bank transfers into the typed tables
+func writeTypedEvent(tx *sql.Tx, height int64, source string, txIndex *int, eventType string, attrs map[string]string) (err error) {
+	switch eventType {
+	case banktypes.EventTypeTransfer:
+		_, err = tx.Exec(
+			"INSERT INTO transfers (height, source, tx_index, sender, recipient, amount) VALUES (?, ?, ?, ?, ?, ?)",
+			height, source, txIndex, attrs[banktypes.AttributeKeySender],
+			attrs[banktypes.AttributeKeyRecipient], attrs[sdk.AttributeKeyAmount],
+		)
+	case minttypes.EventTypeMint:
+		_, err = tx.Exec(
+			"INSERT INTO mint_provisions (height, denom, amount, recipient, inflation_time) VALUES (?, ?, ?, ?, ?)",
+			height, attrs[minttypes.AttributeKeyMintDenom], attrs[minttypes.AttributeKeyMintCoin],
+			attrs[minttypes.AttributeKeyRecipient], attrs[minttypes.AttributeKeyInflationTime],
+		)
+	case guardiantypes.EventTypeAddSuper:
+		_, err = tx.Exec(
+			"INSERT INTO guardian_changes (height, source, tx_index, action, address, operator) VALUES (?, ?, ?, ?, ?, ?)",
+			height, source, txIndex, eventType, attrs[guardiantypes.AttributeKeySuperAddress], attrs[guardiantypes.AttributeKeyAddedBy],
+		)
+	case guardiantypes.EventTypeDeleteSuper:
+		_, err = tx.Exec(
+			"INSERT INTO guardian_changes (height, source, tx_index, action, address, operator) VALUES (?, ?, ?, ?, ?, ?)",
+			height, source, txIndex, eventType, attrs[guardiantypes.AttributeKeySuperAddress], attrs[guardiantypes.AttributeKeyDeletedBy],
+		)
+	}
+	return err
+}
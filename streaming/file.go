@@ -0,0 +1,204 @@
+package streaming
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/irisnet/irishub/streaming/types"
+)
+
+// maxMessageSize is the maximum size of a message read from the streamed files
+const maxMessageSize = 1 << 30
+
+var _ WriteListener = (*FileStreamer)(nil)
+
+// FileStreamer collects the KV writes and the ABCI requests and responses of a block, and
+// writes them to the files of the block on Commit. Two files are written for a block:
+//
+//   - block-{height}-data: the committed KV pairs as length-delimited StoreKVPair messages
+//   - block-{height}-meta: a single length-delimited BlockMetadata message
+//
+// Each message is prefixed by its size encoded as an unsigned varint. The KV pairs are sorted
+// by the store key, keeping the order of the writes of each store. The meta file is written
+// last, so a block is complete once its meta file exists. The state written by InitChain is
+// streamed with the first block.
+type FileStreamer struct {
+	mtx sync.Mutex
+
+	dir        string
+	keepRecent int64
+
+	meta  types.BlockMetadata
+	pairs []types.StoreKVPair
+}
+
+// NewFileStreamer creates a new FileStreamer instance writing to the given directory,
+// the files of the blocks older than keepRecent blocks are removed if it is positive
+func NewFileStreamer(dir string, keepRecent int64) (*FileStreamer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileStreamer{
+		dir:        dir,
+		keepRecent: keepRecent,
+	}, nil
+}
+
+// OnWrite implements WriteListener
+func (s *FileStreamer) OnWrite(storeKey string, key, value []byte, delete bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pairs = append(s.pairs, types.StoreKVPair{
+		StoreKey: storeKey,
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+}
+
+// ListenBeginBlock records the BeginBlock request and response
+func (s *FileStreamer) ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.meta.Height = req.Header.Height
+	s.meta.RequestBeginBlock = &req
+	s.meta.ResponseBeginBlock = &res
+}
+
+// ListenDeliverTx records the DeliverTx request and response
+func (s *FileStreamer) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.meta.DeliverTxs = append(s.meta.DeliverTxs, &types.DeliverTx{Request: &req, Response: &res})
+}
+
+// ListenEndBlock records the EndBlock request and response
+func (s *FileStreamer) ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.meta.RequestEndBlock = &req
+	s.meta.ResponseEndBlock = &res
+}
+
+// ListenCommit writes the files of the committed block and resets the collected records
+func (s *FileStreamer) ListenCommit(res abci.ResponseCommit) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	meta, pairs := s.meta, s.pairs
+	s.meta, s.pairs = types.BlockMetadata{}, nil
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].StoreKey < pairs[j].StoreKey
+	})
+
+	meta.ResponseCommit = &res
+	meta.NumKvPairs = int64(len(pairs))
+
+	dataMsgs := make([]proto.Message, len(pairs))
+	for i := range pairs {
+		dataMsgs[i] = &pairs[i]
+	}
+	if err := s.writeFile(DataFileName(meta.Height), dataMsgs...); err != nil {
+		return err
+	}
+	if err := s.writeFile(MetaFileName(meta.Height), &meta); err != nil {
+		return err
+	}
+
+	if s.keepRecent > 0 && meta.Height > s.keepRecent {
+		height := meta.Height - s.keepRecent
+		for _, name := range []string{MetaFileName(height), DataFileName(height)} {
+			if err := os.Remove(filepath.Join(s.dir, name)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeFile writes the messages to a temporary file which is then renamed to the given name
+func (s *FileStreamer) writeFile(name string, msgs ...proto.Message) error {
+	path := filepath.Join(s.dir, name)
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+
+	w := protoio.NewDelimitedWriter(file)
+	for _, msg := range msgs {
+		if err := w.WriteMsg(msg); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// DataFileName returns the name of the data file of the given height
+func DataFileName(height int64) string {
+	return fmt.Sprintf("block-%d-data", height)
+}
+
+// MetaFileName returns the name of the meta file of the given height
+func MetaFileName(height int64) string {
+	return fmt.Sprintf("block-%d-meta", height)
+}
+
+// ReadBlock reads the metadata and the KV pairs of the block at the given height from the directory
+func ReadBlock(dir string, height int64) (meta types.BlockMetadata, pairs []types.StoreKVPair, err error) {
+	metaFile, err := os.Open(filepath.Join(dir, MetaFileName(height)))
+	if err != nil {
+		return meta, nil, err
+	}
+	defer metaFile.Close()
+
+	if err := protoio.NewDelimitedReader(metaFile, maxMessageSize).ReadMsg(&meta); err != nil {
+		return meta, nil, err
+	}
+
+	dataFile, err := os.Open(filepath.Join(dir, DataFileName(height)))
+	if err != nil {
+		return meta, nil, err
+	}
+	defer dataFile.Close()
+
+	r := protoio.NewDelimitedReader(dataFile, maxMessageSize)
+	for {
+		var pair types.StoreKVPair
+		if err := r.ReadMsg(&pair); err == io.EOF {
+			break
+		} else if err != nil {
+			return meta, nil, err
+		}
+		pairs = append(pairs, pair)
+	}
+
+	if int64(len(pairs)) != meta.NumKvPairs {
+		return meta, nil, fmt.Errorf("expected %d KV pairs, got %d", meta.NumKvPairs, len(pairs))
+	}
+	return meta, pairs, nil
+}
@@ -0,0 +1,137 @@
+package streaming
+
+import (
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// WriteListener is notified of the KV writes and deletes of the module stores
+type WriteListener interface {
+	OnWrite(storeKey string, key, value []byte, delete bool)
+}
+
+var (
+	_ types.CommitMultiStore = (*CommitMultiStore)(nil)
+	_ types.KVStore          = listenKVStore{}
+)
+
+// CommitMultiStore wraps the root multistore so that the writes flushed from its branches,
+// i.e. the block state written on Commit, are passed to the listener. Only the persistent
+// (IAVL) stores are listened to.
+type CommitMultiStore struct {
+	*rootmulti.Store
+
+	db              dbm.DB
+	listener        WriteListener
+	keys            []types.StoreKey
+	keysByName      map[string]types.StoreKey
+	listened        map[types.StoreKey]bool
+	traceWriter     io.Writer
+	traceContext    types.TraceContext
+	interBlockCache types.MultiStorePersistentCache
+}
+
+// NewCommitMultiStore creates a new CommitMultiStore instance
+func NewCommitMultiStore(db dbm.DB, listener WriteListener) *CommitMultiStore {
+	return &CommitMultiStore{
+		Store:      rootmulti.NewStore(db),
+		db:         db,
+		listener:   listener,
+		keysByName: make(map[string]types.StoreKey),
+		listened:   make(map[types.StoreKey]bool),
+	}
+}
+
+// MountStoreWithDB implements CommitMultiStore
+func (s *CommitMultiStore) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db dbm.DB) {
+	s.Store.MountStoreWithDB(key, typ, db)
+
+	s.keys = append(s.keys, key)
+	s.keysByName[key.Name()] = key
+	s.listened[key] = typ == types.StoreTypeIAVL
+}
+
+// SetInterBlockCache implements CommitMultiStore
+func (s *CommitMultiStore) SetInterBlockCache(c types.MultiStorePersistentCache) {
+	s.Store.SetInterBlockCache(c)
+	s.interBlockCache = c
+}
+
+// SetTracer implements MultiStore
+func (s *CommitMultiStore) SetTracer(w io.Writer) types.MultiStore {
+	s.Store.SetTracer(w)
+	s.traceWriter = w
+	return s
+}
+
+// SetTracingContext implements MultiStore
+func (s *CommitMultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	s.Store.SetTracingContext(tc)
+	if s.traceContext != nil {
+		for k, v := range tc {
+			s.traceContext[k] = v
+		}
+	} else {
+		s.traceContext = tc
+	}
+	return s
+}
+
+// CacheMultiStore implements MultiStore, the listened stores are wrapped so that
+// the writes of the returned branch are passed to the listener when it is written
+func (s *CommitMultiStore) CacheMultiStore() types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(s.keys))
+	for _, key := range s.keys {
+		if !s.listened[key] {
+			stores[key] = s.GetCommitKVStore(key)
+			continue
+		}
+
+		// the inter-block cache is unwrapped by GetCommitKVStore, wrap it again
+		// so that the writes are not lost by the cache
+		store := s.GetCommitKVStore(key)
+		if s.interBlockCache != nil {
+			store = s.interBlockCache.GetStoreCache(key, store)
+		}
+		stores[key] = listenKVStore{KVStore: store, storeKey: key.Name(), listener: s.listener}
+	}
+
+	return cachemulti.NewStore(s.db, stores, s.keysByName, s.traceWriter, s.traceContext)
+}
+
+// listenKVStore passes the writes and deletes to the listener
+type listenKVStore struct {
+	types.KVStore
+
+	storeKey string
+	listener WriteListener
+}
+
+// Set implements KVStore
+func (s listenKVStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.listener.OnWrite(s.storeKey, key, value, false)
+}
+
+// Delete implements KVStore
+func (s listenKVStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.listener.OnWrite(s.storeKey, key, nil, true)
+}
+
+// CacheWrap implements CacheWrapper, the branch is written to the listened store
+func (s listenKVStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper, the branch is written to the listened store
+func (s listenKVStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}